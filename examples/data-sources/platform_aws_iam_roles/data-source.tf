@@ -0,0 +1,5 @@
+data "platform_aws_iam_roles" "all" {}
+
+output "aws_iam_role_usernames" {
+  value = data.platform_aws_iam_roles.all.iam_roles[*].username
+}
@@ -0,0 +1,14 @@
+data "platform_capabilities" "instance" {}
+
+locals {
+  capabilities = {
+    for c in data.platform_capabilities.instance.capabilities : c.name => c.supported
+  }
+}
+
+resource "platform_lifecycle" "lifecycle" {
+  # supported is null when the Access version could not be detected
+  count = local.capabilities["lifecycle"] == true ? 1 : 0
+
+  promote_stages = ["qa", "production"]
+}
@@ -0,0 +1,8 @@
+data "platform_license" "current" {}
+
+check "license_expiry" {
+  assert {
+    condition     = data.platform_license.current.days_remaining > 30
+    error_message = "The ${data.platform_license.current.type} license expires on ${data.platform_license.current.valid_through}."
+  }
+}
@@ -0,0 +1,8 @@
+data "platform_role_actions" "all" {}
+
+resource "platform_global_role" "my-read-only-role" {
+  name         = "my-read-only-role"
+  type         = "CUSTOM_GLOBAL"
+  environments = ["DEV", "PROD"]
+  actions      = [for action in data.platform_role_actions.all.actions : action if startswith(action, "READ_")]
+}
@@ -0,0 +1,5 @@
+data "platform_saml_settings_list" "all" {}
+
+output "saml_provider_names" {
+  value = data.platform_saml_settings_list.all.saml_settings[*].name
+}
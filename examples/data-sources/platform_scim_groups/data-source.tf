@@ -0,0 +1,7 @@
+data "platform_scim_groups" "okta-groups" {
+  filter = "displayName sw \"okta-\""
+}
+
+output "okta_group_ids" {
+  value = data.platform_scim_groups.okta-groups.groups[*].id
+}
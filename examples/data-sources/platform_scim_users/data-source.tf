@@ -0,0 +1,7 @@
+data "platform_scim_users" "service-users" {
+  filter = "userName sw \"svc-\""
+}
+
+output "service_usernames" {
+  value = data.platform_scim_users.service-users.users[*].username
+}
@@ -0,0 +1,11 @@
+data "platform_system_info" "instance" {}
+
+resource "platform_license" "license" {
+  count = data.platform_system_info.instance.saas ? 0 : 1
+
+  key = file("artifactory.lic")
+}
+
+output "xray_installed" {
+  value = data.platform_system_info.instance.xray_version != null
+}
@@ -0,0 +1 @@
+terraform import platform_aws_iam_role_mappings.eks default
\ No newline at end of file
@@ -0,0 +1,6 @@
+resource "platform_aws_iam_role_mappings" "eks" {
+  mappings = {
+    "ci-user"     = "arn:aws:iam::000000000000:role/ci"
+    "deploy-user" = "arn:aws:iam::000000000000:role/deploy"
+  }
+}
\ No newline at end of file
@@ -0,0 +1 @@
+terraform import platform_azure_managed_identity.myuser-azure-managed-identity myuser
\ No newline at end of file
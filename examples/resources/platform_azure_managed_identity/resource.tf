@@ -0,0 +1,5 @@
+resource "platform_azure_managed_identity" "myuser-azure-managed-identity" {
+  username  = "myuser"
+  tenant_id = "00000000-0000-0000-0000-000000000000"
+  client_id = "11111111-1111-1111-1111-111111111111"
+}
\ No newline at end of file
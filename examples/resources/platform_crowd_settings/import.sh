@@ -1 +1 @@
-terraform import platform_crowd_settings.my-crowd-settings my-crowd-settings
\ No newline at end of file
+terraform import platform_crowd_settings.my-crowd-settings default
\ No newline at end of file
@@ -9,4 +9,5 @@ resource "platform_crowd_settings" "my-crowd-settings" {
   allow_user_to_access_profile   = false
   direct_authentication          = true
   override_all_groups_upon_login = false
+  verify_connection              = true
 }
\ No newline at end of file
@@ -0,0 +1 @@
+terraform import platform_gcp_service_account.myuser-gcp-service-account myuser
\ No newline at end of file
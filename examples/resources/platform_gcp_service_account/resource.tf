@@ -0,0 +1,4 @@
+resource "platform_gcp_service_account" "myuser-gcp-service-account" {
+  username        = "myuser"
+  service_account = "example@my-project.iam.gserviceaccount.com"
+}
\ No newline at end of file
@@ -0,0 +1 @@
+terraform import platform_global_environment.staging STAGING
\ No newline at end of file
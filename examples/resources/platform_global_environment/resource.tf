@@ -0,0 +1,10 @@
+resource "platform_global_environment" "staging" {
+  name = "STAGING"
+}
+
+resource "platform_global_role" "my-staging-role" {
+  name         = "my-staging-role"
+  type         = "CUSTOM_GLOBAL"
+  environments = [platform_global_environment.staging.name]
+  actions      = ["READ_REPOSITORY", "READ_BUILD"]
+}
\ No newline at end of file
@@ -0,0 +1,13 @@
+resource "platform_ha_licenses" "my-ha-licenses" {
+  keys = [
+    file("licenses/node-1.lic"),
+    file("licenses/node-2.lic"),
+    file("licenses/node-3.lic"),
+  ]
+}
+
+output "license_nodes" {
+  value = {
+    for license in platform_ha_licenses.my-ha-licenses.licenses : license.license_hash => license.node_id
+  }
+}
\ No newline at end of file
@@ -1 +1 @@
-terraform import platform_http_sso_settings.my-http-sso-settings my-http-sso-settings
\ No newline at end of file
+terraform import platform_http_sso_settings.my-http-sso-settings default
\ No newline at end of file
@@ -0,0 +1 @@
+terraform import platform_ldap_group_setting.my-ldap-group-setting my-ldap-group-setting
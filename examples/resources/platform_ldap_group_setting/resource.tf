@@ -0,0 +1,18 @@
+resource "platform_ldap_group_setting" "my-ldap-group-setting" {
+  name                   = "my-ldap-group-setting"
+  enabled_ldap           = platform_ldap_setting.my-ldap-setting.key
+  group_base_dn          = "ou=groups"
+  group_name_attribute   = "cn"
+  group_member_attribute = "uniqueMember"
+  sub_tree               = true
+  filter                 = "(objectClass=groupOfNames)"
+  description_attribute  = "description"
+  strategy               = "STATIC"
+}
+
+resource "platform_saml_settings" "my-saml-settings" {
+  name                  = "my-saml-settings"
+  service_provider_name = "okta"
+  idp_metadata_url      = "https://myaccount.okta.com/app/exk1a2b3c4/sso/saml/metadata"
+  ldap_group_settings   = [platform_ldap_group_setting.my-ldap-group-setting.name]
+}
@@ -0,0 +1 @@
+terraform import platform_ldap_setting.my-ldap-setting my-ldap-setting
@@ -0,0 +1,17 @@
+resource "platform_ldap_setting" "my-ldap-setting" {
+  key                          = "my-ldap-setting"
+  enabled                      = true
+  ldap_url                     = "ldap://ldap.mycompany.com/dc=mycompany,dc=com"
+  user_dn_pattern              = "uid={0},ou=People"
+  search_filter                = "(uid={0})"
+  search_base                  = "ou=people"
+  search_sub_tree              = true
+  manager_dn                   = "cn=admin,dc=mycompany,dc=com"
+  manager_password_wo          = var.ldap_manager_password
+  manager_password_wo_version  = 1
+  email_attribute              = "mail"
+  auto_create_user             = true
+  allow_user_to_access_profile = false
+  paging_support_enabled       = true
+  ldap_poisoning_protection    = true
+}
@@ -5,4 +5,11 @@ bGljZ
 ...
 09Cg==
 EOT
+}
+
+# The license key is not stored in the Terraform state. Requires Terraform 1.11 or later.
+resource "platform_license" "my-ent-license-wo" {
+  name           = "my-enterprise-license"
+  key_wo         = file("licenses/artifactory.lic")
+  key_wo_version = 1
 }
\ No newline at end of file
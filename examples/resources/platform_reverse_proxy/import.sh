@@ -1 +1 @@
-terraform import platform_reverse_proxy.my-reverse-proxy DIRECT
\ No newline at end of file
+terraform import platform_reverse_proxy.my-reverse-proxy default
\ No newline at end of file
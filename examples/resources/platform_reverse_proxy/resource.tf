@@ -9,4 +9,8 @@ resource "platform_reverse_proxy" "my-reverse-proxy" {
   https_port                  = 443
   ssl_key_path                = "/etc/ssl/private/myserver.key"
   ssl_certificate_path        = "/etc/ssl/certs/myserver.crt"
+}
+
+output "nginx_config" {
+  value = platform_reverse_proxy.my-reverse-proxy.generated_config
 }
\ No newline at end of file
@@ -0,0 +1,2 @@
+terraform import platform_role_assignment.my-user-role my-global-role:user:my-user:myproj
+terraform import platform_role_assignment.my-group-project-role my-global-role:group:my-group:myproj
\ No newline at end of file
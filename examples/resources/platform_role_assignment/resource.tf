@@ -0,0 +1,21 @@
+resource "platform_global_role" "my-global-role" {
+  name         = "my-global-role"
+  type         = "CUSTOM_GLOBAL"
+  environments = ["DEV"]
+  actions      = ["READ_REPOSITORY", "READ_BUILD"]
+}
+
+resource "platform_role_assignment" "my-user-role" {
+  role           = platform_global_role.my-global-role.name
+  principal_type = "user"
+  principal      = "my-user"
+  project_key    = "myproj"
+}
+
+resource "platform_role_assignment" "my-group-project-role" {
+  role           = platform_global_role.my-global-role.name
+  principal_type = "group"
+  principal      = "my-group"
+  project_key    = "myproj"
+  authoritative  = true
+}
@@ -14,4 +14,10 @@ resource "platform_saml_settings" "my-okta-saml-settings" {
   sync_groups                  = true
   verify_audience_restriction  = true
   use_encrypted_assertion      = false
-}
\ No newline at end of file
+}
+
+resource "platform_saml_settings" "my-okta-saml-settings-from-metadata" {
+  name                  = "my-okta-saml-settings-from-metadata"
+  service_provider_name = "okta-contractors"
+  idp_metadata_url      = "https://myaccount.okta.com/app/exk1a2b3c4/sso/saml/metadata"
+}
@@ -5,4 +5,25 @@ resource "platform_scim_user" "my-scim-user" {
     value = "test@tempurl.org"
     primary = true
   }]
-}
\ No newline at end of file
+}
+
+resource "platform_scim_user" "my-enterprise-scim-user" {
+  username     = "jane.doe@tempurl.org"
+  display_name = "Jane Doe"
+  external_id  = "00u1a2b3c4"
+
+  name = {
+    given_name  = "Jane"
+    family_name = "Doe"
+  }
+
+  emails = [{
+    value = "jane.doe@tempurl.org"
+    primary = true
+  }]
+
+  enterprise = {
+    employee_number = "1234"
+    department      = "Engineering"
+  }
+}
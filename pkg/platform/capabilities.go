@@ -0,0 +1,193 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/samber/lo"
+)
+
+const (
+	productArtifactory = "Artifactory"
+	productAccess      = "Access"
+)
+
+const (
+	capabilityAWSIAMRole             = "aws_iam_role"
+	capabilityAzureManagedIdentity   = "azure_managed_identity"
+	capabilityGCPServiceAccount      = "gcp_service_account"
+	capabilityGroupRoles             = "group_roles"
+	capabilityLifecycle              = "lifecycle"
+	capabilityOIDCGitHubOrganization = "oidc_github_organization"
+	capabilityOIDCGitHubEnterprise   = "oidc_github_enterprise"
+	capabilityPermission             = "permission"
+)
+
+// capability is a feature of the provider which requires a minimum version of
+// Artifactory or Access.
+type capability struct {
+	Name        string
+	Description string
+	Product     string
+	MinVersion  string
+}
+
+// capabilities is the registry of the version dependent features, sorted by
+// name. Resources check them with checkCapability from ValidateConfig and
+// Create/Update, or branch on them with isCapabilitySupported.
+var capabilities = []capability{
+	{
+		Name:        capabilityAWSIAMRole,
+		Description: "AWS IAM role mappings",
+		Product:     productArtifactory,
+		MinVersion:  awsIAMRoleMinArtifactoryVersion,
+	},
+	{
+		Name:        capabilityAzureManagedIdentity,
+		Description: "Azure managed identity mappings",
+		Product:     productArtifactory,
+		MinVersion:  azureManagedIdentityMinArtifactoryVersion,
+	},
+	{
+		Name:        capabilityGCPServiceAccount,
+		Description: "GCP service account mappings",
+		Product:     productArtifactory,
+		MinVersion:  gcpServiceAccountMinArtifactoryVersion,
+	},
+	{
+		Name:        capabilityGroupRoles,
+		Description: "Group role attributes",
+		Product:     productArtifactory,
+		MinVersion:  groupRolesArtifactoryVersion,
+	},
+	{
+		Name:        capabilityLifecycle,
+		Description: "Lifecycle and lifecycle stages",
+		Product:     productAccess,
+		MinVersion:  minAccessVersionLifecycle,
+	},
+	{
+		Name:        capabilityOIDCGitHubOrganization,
+		Description: "OIDC GitHub organization",
+		Product:     productAccess,
+		MinVersion:  AccessVersion,
+	},
+	{
+		Name:        capabilityOIDCGitHubEnterprise,
+		Description: "OIDC GitHub Enterprise issuer URL and organization",
+		Product:     productAccess,
+		MinVersion:  GithubEnterpriseAccessVersion,
+	},
+	{
+		Name:        capabilityPermission,
+		Description: "Access permissions",
+		Product:     productArtifactory,
+		MinVersion:  "7.72.0",
+	},
+}
+
+func getCapability(name string) (capability, bool) {
+	return lo.Find(capabilities, func(c capability) bool {
+		return c.Name == name
+	})
+}
+
+// detectedVersion returns the version of the product of the capability, empty
+// when it could not be detected.
+func (c capability) detectedVersion(meta util.ProviderMetadata) string {
+	if c.Product == productAccess {
+		return meta.AccessVersion
+	}
+
+	return meta.ArtifactoryVersion
+}
+
+// supported reports whether the detected version supports the capability,
+// with known false when the version is not detected or can not be parsed.
+func (c capability) supported(meta util.ProviderMetadata) (supported, known bool) {
+	detected := c.detectedVersion(meta)
+	if detected == "" {
+		return false, false
+	}
+
+	supported, err := util.CheckVersion(detected, c.MinVersion)
+	if err != nil {
+		return false, false
+	}
+
+	return supported, true
+}
+
+func (c capability) unsupportedDetail(meta util.ProviderMetadata) string {
+	return fmt.Sprintf("%s requires %s %s or later. Detected version: %s.", c.Description, c.Product, c.MinVersion, c.detectedVersion(meta))
+}
+
+func (c capability) unsupportedSummary() string {
+	return fmt.Sprintf("Unsupported %s Version", c.Product)
+}
+
+// isCapabilitySupported reports whether the capability is supported. It is
+// false when the version is not detected, so attributes older versions reject
+// are only sent when they are known to be supported.
+func isCapabilitySupported(meta util.ProviderMetadata, name string) bool {
+	c, ok := getCapability(name)
+	if !ok {
+		return false
+	}
+
+	supported, _ := c.supported(meta)
+	return supported
+}
+
+// checkCapability returns an error when the capability is not supported by the
+// detected version. No error is returned when the version is not detected, e.g.
+// when the provider is not configured yet, and the server reports the error
+// instead.
+func checkCapability(meta util.ProviderMetadata, name string) (ds diag.Diagnostics) {
+	c, ok := getCapability(name)
+	if !ok {
+		ds.AddError("Unknown Capability", fmt.Sprintf("Capability '%s' is not registered. Please report this issue to the provider developers.", name))
+		return
+	}
+
+	if supported, known := c.supported(meta); known && !supported {
+		ds.AddError(c.unsupportedSummary(), c.unsupportedDetail(meta))
+	}
+
+	return
+}
+
+// checkAttributeCapability is checkCapability for an attribute which requires
+// the capability.
+func checkAttributeCapability(meta util.ProviderMetadata, name string, attributePath path.Path) (ds diag.Diagnostics) {
+	c, ok := getCapability(name)
+	if !ok {
+		return checkCapability(meta, name)
+	}
+
+	if supported, known := c.supported(meta); known && !supported {
+		ds.AddAttributeError(
+			attributePath,
+			c.unsupportedSummary(),
+			fmt.Sprintf("Attribute %q: %s", attributePath.String(), c.unsupportedDetail(meta)),
+		)
+	}
+
+	return
+}
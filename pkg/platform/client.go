@@ -0,0 +1,213 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	defaultMaxRetries        = 5
+	defaultMinBackoffSeconds = 1
+	defaultMaxBackoffSeconds = 30
+)
+
+// idempotentMethods are the HTTP methods retried on transient errors. Other
+// methods, e.g. POST, are only retried when the request declares them safe
+// with AddRetryCondition(retryTransientErrors).
+var idempotentMethods = []string{
+	http.MethodGet,
+	http.MethodHead,
+	http.MethodOptions,
+	http.MethodPut,
+	http.MethodDelete,
+}
+
+// transientStatusCodes are the response statuses worth retrying: rate limiting
+// and unavailable or overloaded servers.
+var transientStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// clientConfig is the configuration of the retries and concurrency of the
+// provider client.
+type clientConfig struct {
+	MaxRetries            int
+	MinBackoff            time.Duration
+	MaxBackoff            time.Duration
+	MaxConcurrentRequests int
+}
+
+// retryTransientErrors is a retry condition retrying the request on network
+// errors and transient responses, whatever its method. The client only
+// retries idempotent methods, so requests which are safe to send again, e.g. a
+// POST installing a license, add it with AddRetryCondition.
+func retryTransientErrors(response *resty.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+
+	return response != nil && slices.Contains(transientStatusCodes, response.StatusCode())
+}
+
+// retryIdempotentRequests is the client retry condition, retrying the
+// requests with an idempotent method on network errors and transient
+// responses.
+func retryIdempotentRequests(response *resty.Response, err error) bool {
+	if response == nil || response.Request == nil {
+		return false
+	}
+
+	return slices.Contains(idempotentMethods, response.Request.Method) && retryTransientErrors(response, err)
+}
+
+// retryAfter returns the wait time requested by the Retry-After header of the
+// response, either in seconds or as an HTTP date. Zero lets the client use its
+// exponential backoff. The wait time is capped to the maximum backoff.
+func retryAfter(_ *resty.Client, response *resty.Response) (time.Duration, error) {
+	if response == nil || response.RawResponse == nil {
+		return 0, nil
+	}
+
+	value := response.Header().Get("Retry-After")
+	if value == "" {
+		return 0, nil
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second, nil
+	}
+
+	if date, err := http.ParseTime(value); err == nil && time.Until(date) > 0 {
+		return time.Until(date), nil
+	}
+
+	return 0, nil
+}
+
+// requestContext returns the context of a request, or fallback when the
+// request was sent without a context.
+func requestContext(ctx, fallback context.Context) context.Context {
+	if ctx == context.Background() {
+		return fallback
+	}
+
+	return ctx
+}
+
+// configureClient applies the retry, concurrency and logging configuration to
+// the client. Retries and HTTP calls are logged with the logger of the request
+// context, set with SetContext, or of ctx, the provider configuration context,
+// for the requests sent without a context.
+func configureClient(ctx context.Context, client *resty.Client, config clientConfig) {
+	client.
+		SetRetryCount(config.MaxRetries).
+		SetRetryWaitTime(config.MinBackoff).
+		SetRetryMaxWaitTime(config.MaxBackoff).
+		SetRetryAfter(retryAfter).
+		AddRetryCondition(retryIdempotentRequests).
+		AddRetryHook(func(response *resty.Response, err error) {
+			if response == nil || response.Request == nil {
+				return
+			}
+
+			fields := map[string]any{
+				"method":      response.Request.Method,
+				"url":         response.Request.URL,
+				"attempt":     response.Request.Attempt,
+				"max_retries": config.MaxRetries,
+			}
+			if err != nil {
+				fields["error"] = err.Error()
+			} else {
+				fields["status"] = response.StatusCode()
+				if value := response.Header().Get("Retry-After"); value != "" {
+					fields["retry_after"] = value
+				}
+			}
+
+			tflog.Warn(requestContext(response.Request.Context(), ctx), "retrying request", fields)
+		})
+
+	// The debug log of the client logs the request and response bodies as is,
+	// the HTTP calls are logged with the secrets redacted by the transport
+	// instead.
+	client.
+		SetDebug(false).
+		OnBeforeRequest(func(_ *resty.Client, request *resty.Request) error {
+			request.SetDebug(false)
+			return nil
+		})
+
+	var transport http.RoundTripper = newLoggingTransport(ctx, client.GetClient().Transport)
+	if config.MaxConcurrentRequests > 0 {
+		transport = &concurrencyLimitTransport{
+			next:      transport,
+			semaphore: make(chan struct{}, config.MaxConcurrentRequests),
+		}
+	}
+	client.SetTransport(transport)
+}
+
+// concurrencyLimitTransport limits the number of requests in flight. A slot is
+// held until the response body is closed, and is not held while waiting
+// before a retry. Waiting for a slot stops when the request context is done,
+// e.g. when Terraform is interrupted.
+type concurrencyLimitTransport struct {
+	next      http.RoundTripper
+	semaphore chan struct{}
+}
+
+func (t *concurrencyLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	select {
+	case t.semaphore <- struct{}{}:
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+
+	release := sync.OnceFunc(func() { <-t.semaphore })
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		release()
+		return nil, err
+	}
+
+	resp.Body = &releaseOnCloseBody{ReadCloser: resp.Body, release: release}
+
+	return resp, nil
+}
+
+type releaseOnCloseBody struct {
+	io.ReadCloser
+	release func()
+}
+
+func (b *releaseOnCloseBody) Close() error {
+	defer b.release()
+	return b.ReadCloser.Close()
+}
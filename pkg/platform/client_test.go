@@ -0,0 +1,245 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+func TestConcurrencyLimitTransport_requestContextDone(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		<-release
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client := resty.New().SetBaseURL(server.URL)
+	configureClient(context.Background(), client, clientConfig{MaxConcurrentRequests: 1})
+
+	// The first request holds the only slot until the server responds
+	go client.R().Get("/")
+	time.Sleep(100 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	_, err := client.R().SetContext(ctx).Get("/")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the request waiting for a slot to stop with its context, got %v", err)
+	}
+}
+
+// newRetryTestServer returns a server answering the first failures requests
+// with status and the Retry-After header if set, then 200, and the number of
+// requests it received.
+func newRetryTestServer(t *testing.T, status, failures int, retryAfter func() string) (*httptest.Server, *atomic.Int32) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if int(attempts.Add(1)) > failures {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		if retryAfter != nil {
+			w.Header().Set("Retry-After", retryAfter())
+		}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &attempts
+}
+
+func newRetryTestClient(baseURL string, config clientConfig) *resty.Client {
+	client := resty.New().SetBaseURL(baseURL)
+	configureClient(context.Background(), client, config)
+	return client
+}
+
+var retryTestConfig = clientConfig{
+	MaxRetries: 2,
+	MinBackoff: time.Millisecond,
+	MaxBackoff: 5 * time.Millisecond,
+}
+
+func TestClient_retriesIdempotentRequests(t *testing.T) {
+	for _, method := range []string{http.MethodGet, http.MethodPut, http.MethodDelete} {
+		for _, status := range []int{http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable} {
+			t.Run(fmt.Sprintf("%s_%d", method, status), func(t *testing.T) {
+				server, attempts := newRetryTestServer(t, status, 100, nil)
+
+				response, err := newRetryTestClient(server.URL, retryTestConfig).R().Execute(method, "/")
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if response.StatusCode() != status {
+					t.Errorf("expected status %d, got %d", status, response.StatusCode())
+				}
+				if got := attempts.Load(); got != int32(retryTestConfig.MaxRetries+1) {
+					t.Errorf("expected %d attempts, got %d", retryTestConfig.MaxRetries+1, got)
+				}
+			})
+		}
+	}
+}
+
+func TestClient_retriesUntilSuccess(t *testing.T) {
+	server, attempts := newRetryTestServer(t, http.StatusServiceUnavailable, 1, nil)
+
+	response, err := newRetryTestClient(server.URL, retryTestConfig).R().Get("/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if response.StatusCode() != http.StatusOK || attempts.Load() != 2 {
+		t.Errorf("expected success on the second attempt, got status %d after %d attempts", response.StatusCode(), attempts.Load())
+	}
+}
+
+func TestClient_doesNotRetryPost(t *testing.T) {
+	server, attempts := newRetryTestServer(t, http.StatusServiceUnavailable, 100, nil)
+
+	if _, err := newRetryTestClient(server.URL, retryTestConfig).R().Post("/"); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := attempts.Load(); got != 1 {
+		t.Errorf("expected 1 attempt, got %d", got)
+	}
+}
+
+func TestClient_doesNotRetryClientErrors(t *testing.T) {
+	server, attempts := newRetryTestServer(t, http.StatusBadRequest, 100, nil)
+
+	if _, err := newRetryTestClient(server.URL, retryTestConfig).R().Get("/"); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := attempts.Load(); got != 1 {
+		t.Errorf("expected 1 attempt, got %d", got)
+	}
+}
+
+func TestClient_retriesSafePost(t *testing.T) {
+	server, attempts := newRetryTestServer(t, http.StatusTooManyRequests, 100, nil)
+
+	_, err := newRetryTestClient(server.URL, retryTestConfig).R().
+		AddRetryCondition(retryTransientErrors).
+		Post("/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := attempts.Load(); got != int32(retryTestConfig.MaxRetries+1) {
+		t.Errorf("expected %d attempts, got %d", retryTestConfig.MaxRetries+1, got)
+	}
+}
+
+func TestClient_honorsRetryAfter(t *testing.T) {
+	testCases := map[string]struct {
+		retryAfter func() string
+		maxBackoff time.Duration
+		minWait    time.Duration
+		maxWait    time.Duration
+	}{
+		"seconds": {
+			retryAfter: func() string { return "1" },
+			maxBackoff: 5 * time.Second,
+			minWait:    time.Second,
+			maxWait:    3 * time.Second,
+		},
+		"HTTP date": {
+			// HTTP dates have a one second precision
+			retryAfter: func() string { return time.Now().Add(2 * time.Second).UTC().Format(http.TimeFormat) },
+			maxBackoff: 5 * time.Second,
+			minWait:    time.Second,
+			maxWait:    3 * time.Second,
+		},
+		"capped by max backoff": {
+			retryAfter: func() string { return "30" },
+			maxBackoff: 200 * time.Millisecond,
+			minWait:    200 * time.Millisecond,
+			maxWait:    2 * time.Second,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			server, attempts := newRetryTestServer(t, http.StatusTooManyRequests, 1, testCase.retryAfter)
+
+			client := newRetryTestClient(server.URL, clientConfig{
+				MaxRetries: 1,
+				MinBackoff: time.Millisecond,
+				MaxBackoff: testCase.maxBackoff,
+			})
+
+			start := time.Now()
+			response, err := client.R().Get("/")
+			wait := time.Since(start)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if response.StatusCode() != http.StatusOK || attempts.Load() != 2 {
+				t.Fatalf("expected success on the second attempt, got status %d after %d attempts", response.StatusCode(), attempts.Load())
+			}
+			if wait < testCase.minWait || wait > testCase.maxWait {
+				t.Errorf("expected a wait between %s and %s, got %s", testCase.minWait, testCase.maxWait, wait)
+			}
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	testCases := map[string]struct {
+		header   string
+		expected func(time.Duration) bool
+	}{
+		"seconds":      {"3", func(d time.Duration) bool { return d == 3*time.Second }},
+		"HTTP date":    {time.Now().Add(10 * time.Second).UTC().Format(http.TimeFormat), func(d time.Duration) bool { return d > 8*time.Second && d <= 10*time.Second }},
+		"past date":    {time.Now().Add(-10 * time.Second).UTC().Format(http.TimeFormat), func(d time.Duration) bool { return d == 0 }},
+		"invalid":      {"soon", func(d time.Duration) bool { return d == 0 }},
+		"not returned": {"", func(d time.Duration) bool { return d == 0 }},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			header := http.Header{}
+			if testCase.header != "" {
+				header.Set("Retry-After", testCase.header)
+			}
+
+			wait, err := retryAfter(nil, &resty.Response{RawResponse: &http.Response{Header: header}})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !testCase.expected(wait) {
+				t.Errorf("unexpected wait %s for Retry-After %q", wait, testCase.header)
+			}
+		})
+	}
+}
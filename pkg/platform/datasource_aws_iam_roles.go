@@ -0,0 +1,129 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func NewAWSIAMRolesDataSource() datasource.DataSource {
+	return &AWSIAMRolesDataSource{
+		TypeName: "platform_aws_iam_roles",
+	}
+}
+
+type AWSIAMRolesDataSource struct {
+	ProviderData util.ProviderMetadata
+	TypeName     string
+}
+
+type AWSIAMRolesDataSourceModel struct {
+	IAMRoles []AWSIAMRoleResourceModel `tfsdk:"iam_roles"`
+	Mappings types.Map                 `tfsdk:"mappings"`
+}
+
+func (d *AWSIAMRolesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = d.TypeName
+}
+
+func (d *AWSIAMRolesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"iam_roles": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"username": schema.StringAttribute{
+							Computed:    true,
+							Description: "The JFrog Platform user name.",
+						},
+						"iam_role": schema.StringAttribute{
+							Computed:    true,
+							Description: "The AWS IAM role.",
+						},
+					},
+				},
+				Computed:    true,
+				Description: "All AWS IAM role mappings of the instance, sorted by user name.",
+			},
+			"mappings": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "All AWS IAM role mappings of the instance, as a map of user name to AWS IAM role.",
+			},
+		},
+		MarkdownDescription: "Provides a data source to list the AWS IAM roles of JFrog platform users, including the ones not managed by Terraform. For more information, see [Passwordless Access for Amazon EKS](https://jfrog.com/help/r/jfrog-installation-setup-documentation/passwordless-access-for-amazon-eks).\n\n" +
+			"->Only available for Artifactory 7.90.10 or later.",
+	}
+}
+
+func (d *AWSIAMRolesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+func (d *AWSIAMRolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	go util.SendUsage(ctx, d.ProviderData.Client.R(), d.ProviderData.ProductId, fmt.Sprintf("DataSource/%s/READ", d.TypeName))
+
+	resp.Diagnostics.Append(checkCapability(d.ProviderData, capabilityAWSIAMRole)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roles, err := listAWSIAMRoles(ctx, d.ProviderData.Client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Data Source",
+			"An unexpected error occurred while listing AWS IAM roles. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+		return
+	}
+
+	sort.Slice(roles, func(i, j int) bool {
+		return roles[i].Username < roles[j].Username
+	})
+
+	data := AWSIAMRolesDataSourceModel{
+		IAMRoles: make([]AWSIAMRoleResourceModel, 0, len(roles)),
+	}
+	mappings := make(map[string]string, len(roles))
+	for _, role := range roles {
+		data.IAMRoles = append(data.IAMRoles, AWSIAMRoleResourceModel{
+			Username: types.StringValue(role.Username),
+			IAMRole:  types.StringValue(role.IAMRole),
+		})
+		mappings[role.Username] = role.IAMRole
+	}
+
+	m, ds := types.MapValueFrom(ctx, types.StringType, mappings)
+	resp.Diagnostics.Append(ds...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Mappings = m
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
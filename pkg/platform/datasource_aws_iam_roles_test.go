@@ -0,0 +1,56 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+)
+
+func TestAccAWSIAMRolesDataSource(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("test-aws-iam-role", "platform_aws_iam_role")
+	dataSourceName := fmt.Sprintf("data.platform_aws_iam_roles.%s", name)
+
+	config := fmt.Sprintf(`
+	resource "platform_aws_iam_role" "%[1]s" {
+		username = "anonymous"
+		iam_role = "arn:aws:iam::000000000000:role/%[1]s"
+	}
+
+	data "platform_aws_iam_roles" "%[1]s" {
+		depends_on = [platform_aws_iam_role.%[1]s]
+	}`, name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(),
+		CheckDestroy:             testAccAWSIAMRoleDestroy(fqrn),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "iam_roles.*", map[string]string{
+						"username": "anonymous",
+						"iam_role": fmt.Sprintf("arn:aws:iam::000000000000:role/%s", name),
+					}),
+					resource.TestCheckResourceAttr(dataSourceName, "mappings.anonymous", fmt.Sprintf("arn:aws:iam::000000000000:role/%s", name)),
+				),
+			},
+		},
+	})
+}
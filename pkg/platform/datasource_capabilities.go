@@ -0,0 +1,133 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func NewCapabilitiesDataSource() datasource.DataSource {
+	return &CapabilitiesDataSource{
+		TypeName: "platform_capabilities",
+	}
+}
+
+type CapabilitiesDataSource struct {
+	ProviderData util.ProviderMetadata
+	TypeName     string
+}
+
+type CapabilitiesDataSourceModel struct {
+	ArtifactoryVersion types.String      `tfsdk:"artifactory_version"`
+	AccessVersion      types.String      `tfsdk:"access_version"`
+	Capabilities       []CapabilityModel `tfsdk:"capabilities"`
+}
+
+type CapabilityModel struct {
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Product     types.String `tfsdk:"product"`
+	MinVersion  types.String `tfsdk:"min_version"`
+	Supported   types.Bool   `tfsdk:"supported"`
+}
+
+func (d *CapabilitiesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = d.TypeName
+}
+
+func (d *CapabilitiesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"artifactory_version": schema.StringAttribute{
+				Computed:    true,
+				Description: "The Artifactory version detected by the provider. Empty when it could not be detected.",
+			},
+			"access_version": schema.StringAttribute{
+				Computed:    true,
+				Description: "The Access version detected by the provider. Empty when it could not be detected.",
+			},
+			"capabilities": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the capability.",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "The features of the provider requiring the capability.",
+						},
+						"product": schema.StringAttribute{
+							Computed:    true,
+							Description: "The product whose version is checked: `Artifactory` or `Access`.",
+						},
+						"min_version": schema.StringAttribute{
+							Computed:    true,
+							Description: "The minimum version of `product` supporting the capability.",
+						},
+						"supported": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the detected version of `product` supports the capability. Null when the version could not be detected.",
+						},
+					},
+				},
+				Computed:    true,
+				Description: "The version dependent capabilities of the provider, sorted by name.",
+			},
+		},
+		MarkdownDescription: "Provides a data source listing the provider features which require a minimum version of Artifactory or Access, and whether the connected instance supports them. The same registry is used to validate the resources, so e.g. a module can skip an optional resource by checking the `supported` attribute of its capability.",
+	}
+}
+
+func (d *CapabilitiesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+func (d *CapabilitiesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	go util.SendUsage(ctx, d.ProviderData.Client.R(), d.ProviderData.ProductId, fmt.Sprintf("DataSource/%s/READ", d.TypeName))
+
+	data := CapabilitiesDataSourceModel{
+		ArtifactoryVersion: types.StringValue(d.ProviderData.ArtifactoryVersion),
+		AccessVersion:      types.StringValue(d.ProviderData.AccessVersion),
+		Capabilities:       make([]CapabilityModel, 0, len(capabilities)),
+	}
+
+	for _, c := range capabilities {
+		supported := types.BoolNull()
+		if ok, known := c.supported(d.ProviderData); known {
+			supported = types.BoolValue(ok)
+		}
+
+		data.Capabilities = append(data.Capabilities, CapabilityModel{
+			Name:        types.StringValue(c.Name),
+			Description: types.StringValue(c.Description),
+			Product:     types.StringValue(c.Product),
+			MinVersion:  types.StringValue(c.MinVersion),
+			Supported:   supported,
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
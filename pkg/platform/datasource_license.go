@@ -0,0 +1,135 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func NewLicenseDataSource() datasource.DataSource {
+	return &LicenseDataSource{
+		TypeName: "platform_license",
+	}
+}
+
+type LicenseDataSource struct {
+	ProviderData util.ProviderMetadata
+	TypeName     string
+}
+
+type LicenseDataSourceModel struct {
+	Type                  types.String `tfsdk:"type"`
+	ValidThrough          types.String `tfsdk:"valid_through"`
+	ValidThroughTimestamp types.String `tfsdk:"valid_through_timestamp"`
+	DaysRemaining         types.Int64  `tfsdk:"days_remaining"`
+	LicensedTo            types.String `tfsdk:"licensed_to"`
+}
+
+func (d *LicenseDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = d.TypeName
+}
+
+func (d *LicenseDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Type of the license.",
+			},
+			"valid_through": schema.StringAttribute{
+				Computed:    true,
+				Description: "Date of the license is valid through, as returned by Artifactory.",
+			},
+			"valid_through_timestamp": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "`valid_through` in RFC 3339 format. Not set when the date cannot be parsed.",
+			},
+			"days_remaining": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of days until the license expires. `0` on the last day the license is valid, negative once it has expired. Not set when `valid_through` cannot be parsed.",
+			},
+			"licensed_to": schema.StringAttribute{
+				Computed:    true,
+				Description: "Customer name the license belongs to.",
+			},
+		},
+		MarkdownDescription: "Provides a JFrog [license](https://jfrog.com/help/r/jfrog-platform-administration-documentation/managing-licenses) data source to read the installed license, e.g. to check its expiry.\n\n~>Only available for self-hosted instances.",
+	}
+}
+
+func (d *LicenseDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+func (d *LicenseDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	go util.SendUsage(ctx, d.ProviderData.Client.R(), d.ProviderData.ProductId, fmt.Sprintf("DataSource/%s/READ", d.TypeName))
+
+	var license licenseAPIGetModel
+
+	response, err := d.ProviderData.Client.R().SetContext(ctx).
+		SetResult(&license).
+		Get(licenseGetEndpoint)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Data Source",
+			"An unexpected error occurred while reading the license. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+		return
+	}
+
+	if response.IsError() {
+		resp.Diagnostics.AddError(
+			"Unable to Read Data Source",
+			"An unexpected error occurred while reading the license. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+response.String(),
+		)
+		return
+	}
+
+	data := LicenseDataSourceModel{
+		Type:                  types.StringValue(license.Type),
+		ValidThrough:          types.StringValue(license.ValidThrough),
+		ValidThroughTimestamp: types.StringNull(),
+		DaysRemaining:         types.Int64Null(),
+		LicensedTo:            types.StringValue(license.LicensedTo),
+	}
+
+	validThrough, err := parseLicenseValidThrough(license.ValidThrough)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Parse License Expiry",
+			fmt.Sprintf("valid_through_timestamp and days_remaining are not set: %s", err),
+		)
+	} else {
+		data.ValidThroughTimestamp = types.StringValue(validThrough.Format(time.RFC3339))
+		data.DaysRemaining = types.Int64Value(licenseDaysRemaining(validThrough, time.Now()))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,101 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+const (
+	roleActionsSourceInstance = "instance"
+	roleActionsSourceProvider = "provider"
+)
+
+func NewRoleActionsDataSource() datasource.DataSource {
+	return &RoleActionsDataSource{
+		TypeName: "platform_role_actions",
+	}
+}
+
+type RoleActionsDataSource struct {
+	ProviderData util.ProviderMetadata
+	TypeName     string
+}
+
+type RoleActionsDataSourceModel struct {
+	Actions types.List   `tfsdk:"actions"`
+	Source  types.String `tfsdk:"source"`
+}
+
+func (d *RoleActionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = d.TypeName
+}
+
+func (d *RoleActionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"actions": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Actions allowed in the `actions` of `platform_global_role`.",
+			},
+			"source": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: fmt.Sprintf("`%s` when the actions are read from the Access roles metadata of the instance, `%s` when the instance does not provide them and the actions known to the provider are used instead.", roleActionsSourceInstance, roleActionsSourceProvider),
+			},
+		},
+		MarkdownDescription: "Provides the catalog of actions supported by the instance for [global roles](https://jfrog.com/help/r/jfrog-platform-administration-documentation/global-and-project-role-types).",
+	}
+}
+
+func (d *RoleActionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+func (d *RoleActionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	go util.SendUsage(ctx, d.ProviderData.Client.R(), d.ProviderData.ProductId, fmt.Sprintf("DataSource/%s/READ", d.TypeName))
+
+	data := RoleActionsDataSourceModel{
+		Source: types.StringValue(roleActionsSourceInstance),
+	}
+
+	actions, err := getGlobalRoleActions(ctx, d.ProviderData.Client)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Read Role Actions",
+			fmt.Sprintf("The actions known to the provider are used instead. Error: %s", err.Error()),
+		)
+		data.Source = types.StringValue(roleActionsSourceProvider)
+	}
+
+	actionsList, ds := types.ListValueFrom(ctx, types.StringType, actions)
+	resp.Diagnostics.Append(ds...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Actions = actionsList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,45 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+)
+
+func TestAccRoleActionsDataSource(t *testing.T) {
+	_, _, name := testutil.MkNames("test-role-actions", "platform_role_actions")
+	dataSourceName := fmt.Sprintf("data.platform_role_actions.%s", name)
+
+	config := fmt.Sprintf(`data "platform_role_actions" "%s" {}`, name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "actions.*", "READ_REPOSITORY"),
+					resource.TestMatchResourceAttr(dataSourceName, "source", regexp.MustCompile(`^(instance|provider)$`)),
+				),
+			},
+		},
+	})
+}
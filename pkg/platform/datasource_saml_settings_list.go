@@ -0,0 +1,195 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func NewSAMLSettingsListDataSource() datasource.DataSource {
+	return &SAMLSettingsListDataSource{
+		TypeName: "platform_saml_settings_list",
+	}
+}
+
+type SAMLSettingsListDataSource struct {
+	ProviderData util.ProviderMetadata
+	TypeName     string
+}
+
+type SAMLSettingsListDataSourceModel struct {
+	SAMLSettings []SAMLSettingsListItemModel `tfsdk:"saml_settings"`
+}
+
+type SAMLSettingsListItemModel struct {
+	Name                      types.String `tfsdk:"name"`
+	Enable                    types.Bool   `tfsdk:"enable"`
+	LoginURL                  types.String `tfsdk:"login_url"`
+	LogoutURL                 types.String `tfsdk:"logout_url"`
+	ServiceProviderName       types.String `tfsdk:"service_provider_name"`
+	EmailAttribute            types.String `tfsdk:"email_attribute"`
+	GroupAttribute            types.String `tfsdk:"group_attribute"`
+	NameIDAttribute           types.String `tfsdk:"name_id_attribute"`
+	AllowUserToAccessProfile  types.Bool   `tfsdk:"allow_user_to_access_profile"`
+	AutoRedirect              types.Bool   `tfsdk:"auto_redirect"`
+	SyncGroups                types.Bool   `tfsdk:"sync_groups"`
+	VerifyAudienceRestriction types.Bool   `tfsdk:"verify_audience_restriction"`
+	UseEncryptedAssertion     types.Bool   `tfsdk:"use_encrypted_assertion"`
+	AutoUserCreation          types.Bool   `tfsdk:"auto_user_creation"`
+	LDAPGroupSettings         types.Set    `tfsdk:"ldap_group_settings"`
+	CertificateFingerprint    types.String `tfsdk:"certificate_fingerprint"`
+	CertificateNotAfter       types.String `tfsdk:"certificate_not_after"`
+}
+
+func (d *SAMLSettingsListDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = d.TypeName
+}
+
+func (d *SAMLSettingsListDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"saml_settings": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "SAML Settings name.",
+						},
+						"enable": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether SAML SSO is enabled for this provider.",
+						},
+						"login_url": schema.StringAttribute{
+							Computed: true,
+						},
+						"logout_url": schema.StringAttribute{
+							Computed: true,
+						},
+						"service_provider_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"email_attribute": schema.StringAttribute{
+							Computed: true,
+						},
+						"group_attribute": schema.StringAttribute{
+							Computed: true,
+						},
+						"name_id_attribute": schema.StringAttribute{
+							Computed: true,
+						},
+						"allow_user_to_access_profile": schema.BoolAttribute{
+							Computed: true,
+						},
+						"auto_redirect": schema.BoolAttribute{
+							Computed: true,
+						},
+						"sync_groups": schema.BoolAttribute{
+							Computed: true,
+						},
+						"verify_audience_restriction": schema.BoolAttribute{
+							Computed: true,
+						},
+						"use_encrypted_assertion": schema.BoolAttribute{
+							Computed: true,
+						},
+						"auto_user_creation": schema.BoolAttribute{
+							Computed: true,
+						},
+						"ldap_group_settings": schema.SetAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"certificate_fingerprint": schema.StringAttribute{
+							Computed:    true,
+							Description: "SHA-256 fingerprint of the IdP certificate.",
+						},
+						"certificate_not_after": schema.StringAttribute{
+							Computed:    true,
+							Description: "Expiry date of the IdP certificate, in RFC 3339 format.",
+						},
+					},
+				},
+				Computed:    true,
+				Description: "All SAML SSO configurations of the instance.",
+			},
+		},
+		MarkdownDescription: "Provides a JFrog [SAML SSO Settings](https://jfrog.com/help/r/jfrog-platform-administration-documentation/saml-sso) list data source to enumerate every configured SAML identity provider, including the ones not managed by Terraform.",
+	}
+}
+
+func (d *SAMLSettingsListDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+func (d *SAMLSettingsListDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	go util.SendUsage(ctx, d.ProviderData.Client.R(), d.ProviderData.ProductId, fmt.Sprintf("DataSource/%s/READ", d.TypeName))
+
+	samlSettings, err := listSAMLSettings(ctx, d.ProviderData.Client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Data Source",
+			"An unexpected error occurred while listing SAML settings. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+		return
+	}
+
+	data := SAMLSettingsListDataSourceModel{
+		SAMLSettings: make([]SAMLSettingsListItemModel, 0, len(samlSettings)),
+	}
+	for _, s := range samlSettings {
+		var model SAMLSettingsResourceModelV2
+		resp.Diagnostics.Append(model.fromAPIModel(ctx, &s)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Certificates that can not be parsed leave the details null
+		_ = model.setCertificateDetails()
+
+		data.SAMLSettings = append(data.SAMLSettings, SAMLSettingsListItemModel{
+			Name:                      model.Name,
+			Enable:                    model.Enable,
+			LoginURL:                  model.LoginURL,
+			LogoutURL:                 model.LogoutURL,
+			ServiceProviderName:       model.ServiceProviderName,
+			EmailAttribute:            model.EmailAttribute,
+			GroupAttribute:            model.GroupAttribute,
+			NameIDAttribute:           model.NameIDAttribute,
+			AllowUserToAccessProfile:  model.AllowUserToAccessProfile,
+			AutoRedirect:              model.AutoRedirect,
+			SyncGroups:                model.SyncGroups,
+			VerifyAudienceRestriction: model.VerifyAudienceRestriction,
+			UseEncryptedAssertion:     model.UseEncryptedAssertion,
+			AutoUserCreation:          model.AutoUserCreation,
+			LDAPGroupSettings:         model.LDAPGroupSettings,
+			CertificateFingerprint:    model.CertificateFingerprint,
+			CertificateNotAfter:       model.CertificateNotAfter,
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,78 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccSAMLSettingsListDataSource(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("test-saml-settings", "platform_saml_settings")
+	dataSourceName := fmt.Sprintf("data.platform_saml_settings_list.%s", name)
+
+	temp := `
+	resource "platform_saml_settings" "{{ .name }}" {
+		name                         = "{{ .name }}"
+		enable                       = false
+		certificate                  = "{{ .certificate }}"
+		login_url                    = "http://tempurl.org/login"
+		logout_url                   = "http://tempurl.org/logout"
+		service_provider_name        = "{{ .name }}"
+		auto_user_creation           = true
+		allow_user_to_access_profile = true
+		auto_redirect                = false
+		sync_groups                  = false
+		verify_audience_restriction  = true
+		use_encrypted_assertion      = false
+	}
+
+	data "platform_saml_settings_list" "{{ .name }}" {
+		depends_on = [platform_saml_settings.{{ .name }}]
+	}`
+
+	testData := map[string]string{
+		"name":        name,
+		"certificate": "MIICTjCCAbegAwIBAgIBADANBgkqhkiG9w0BAQ0FADBEMQswCQYDVQQGEwJ1czELMAkGA1UECAwCQ0ExFjAUBgNVBAoMDUpGcm9nIFRlc3RpbmcxEDAOBgNVBAMMB1Rlc3RpbmcwHhcNMjQwODA4MTgzNjMxWhcNMjUwODA4MTgzNjMxWjBEMQswCQYDVQQGEwJ1czELMAkGA1UECAwCQ0ExFjAUBgNVBAoMDUpGcm9nIFRlc3RpbmcxEDAOBgNVBAMMB1Rlc3RpbmcwgZ8wDQYJKoZIhvcNAQEBBQADgY0AMIGJAoGBAOPwKU3SxuRaJply2by60NxYmbIPfelhM6sObgPRXbm49Mz4o1nbwH/vwhz1K+klVO4hOiKc5aP5GtQEoBejZbxOXlYlf8YirNqbtEXlIattvZA3tlC8O9oNOzBuT6tRdAA9CvN035p17fN0tpejz7Ptn1G1yUAt9klTUBBZ8eERAgMBAAGjUDBOMB0GA1UdDgQWBBR2y2SefjbqeSHTj+URrKc540YkGTAfBgNVHSMEGDAWgBR2y2SefjbqeSHTj+URrKc540YkGTAMBgNVHRMEBTADAQH/MA0GCSqGSIb3DQEBDQUAA4GBAKxnkFRgLZnQ4U6fWjfuJnx29cKbIq4oBr9RuWEKH2Hhx+jWy/3baNrxE0AsNWTLX6gGVd2qJbfae803AN6ZLx+VrLCWKl+c5MTTZBhuX6G/JvWviavE44P1U4cl2c6w4qvAmY+SY0cnJeWGLCBJ2vJ/fauXS/TIr0IfziSRcVYY",
+	}
+
+	config := util.ExecuteTemplate(name, temp, testData)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(),
+		CheckDestroy:             testAccSamlSettingsDestroy(fqrn),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "saml_settings.*", map[string]string{
+						"name":                    testData["name"],
+						"enable":                  "false",
+						"login_url":               "http://tempurl.org/login",
+						"logout_url":              "http://tempurl.org/logout",
+						"service_provider_name":   testData["name"],
+						"auto_redirect":           "false",
+						"certificate_fingerprint": "D5:54:7B:4D:40:0D:1B:0F:82:DA:A5:D1:24:B0:96:40:0D:10:6D:B6:3A:76:07:37:5D:31:36:54:66:FD:98:E2",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,153 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+var scimGroupsDataSourceAttributeTypes map[string]attr.Type = map[string]attr.Type{
+	"id":           types.StringType,
+	"display_name": types.StringType,
+	"members":      types.SetType{ElemType: types.ObjectType{AttrTypes: SCIMGroupMemberResourceModelAttributeType}},
+	"meta":         types.MapType{ElemType: types.StringType},
+}
+
+func NewSCIMGroupsDataSource() datasource.DataSource {
+	return &SCIMGroupsDataSource{
+		TypeName: "platform_scim_groups",
+	}
+}
+
+type SCIMGroupsDataSource struct {
+	ProviderData util.ProviderMetadata
+	TypeName     string
+}
+
+type SCIMGroupsDataSourceModel struct {
+	Filter types.String `tfsdk:"filter"`
+	Groups types.List   `tfsdk:"groups"`
+}
+
+func (d *SCIMGroupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = d.TypeName
+}
+
+func (d *SCIMGroupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"filter": scimFilterAttribute,
+			"groups": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Group ID",
+						},
+						"display_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"members": schema.SetNestedAttribute{
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"value": schema.StringAttribute{
+										Computed: true,
+									},
+									"display": schema.StringAttribute{
+										Computed: true,
+									},
+								},
+							},
+							Computed: true,
+						},
+						"meta": schema.MapAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+				Computed:            true,
+				MarkdownDescription: "SCIM groups matching `filter`, in the same shape as the `platform_scim_group` resource.",
+			},
+		},
+		MarkdownDescription: "Provides a JFrog [SCIM](https://jfrog.com/help/r/jfrog-platform-administration-documentation/scim) groups data source to look up groups provisioned with the SCIM protocol.",
+	}
+}
+
+func (d *SCIMGroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+func (d *SCIMGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	go util.SendUsage(ctx, d.ProviderData.Client.R(), d.ProviderData.ProductId, fmt.Sprintf("DataSource/%s/READ", d.TypeName))
+
+	var data SCIMGroupsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groups, err := listSCIMResources[SCIMGroupAPIModel](ctx, d.ProviderData.Client, SCIMGroupsEndpoint, data.Filter.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Data Source",
+			"An unexpected error occurred while listing SCIM groups. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+		return
+	}
+
+	groupValues := make([]attr.Value, 0, len(groups))
+	for _, group := range groups {
+		var model SCIMGroupResourceModel
+		resp.Diagnostics.Append(model.fromAPIModel(ctx, &group)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		groupValue, ds := types.ObjectValueFrom(ctx, scimGroupsDataSourceAttributeTypes, model)
+		resp.Diagnostics.Append(ds...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		groupValues = append(groupValues, groupValue)
+	}
+
+	groupsList, ds := types.ListValue(
+		types.ObjectType{AttrTypes: scimGroupsDataSourceAttributeTypes},
+		groupValues,
+	)
+	resp.Diagnostics.Append(ds...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Groups = groupsList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
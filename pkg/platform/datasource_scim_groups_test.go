@@ -0,0 +1,80 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccSCIMGroupsDataSource_filter(t *testing.T) {
+	_, _, username := testutil.MkNames("test-scim-user", "platform_scim_user")
+	_, fqrn, name := testutil.MkNames("test-scim-group", "platform_scim_group")
+	dataSourceName := fmt.Sprintf("data.platform_scim_groups.%s", name)
+
+	temp := `
+	resource "platform_scim_user" "{{ .username }}" {
+		username = "{{ .email }}"
+		active   = true
+		emails = [{
+			value = "{{ .email }}"
+			primary = true
+		}]
+	}
+
+	resource "platform_scim_group" "{{ .name }}" {
+		id = "{{ .name }}"
+		display_name = "{{ .name }}"
+		members = [{
+			value = platform_scim_user.{{ .username }}.username
+			display = platform_scim_user.{{ .username }}.username
+		}]
+	}
+
+	data "platform_scim_groups" "{{ .name }}" {
+		filter = "displayName eq \"${platform_scim_group.{{ .name }}.display_name}\""
+	}`
+
+	testData := map[string]string{
+		"username": username,
+		"email":    fmt.Sprintf("%s@tempurl.org", username),
+		"name":     name,
+	}
+
+	config := util.ExecuteTemplate(name, temp, testData)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(),
+		CheckDestroy:             testAccSCIMGroupDestroy(fqrn),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "groups.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "groups.0.id", testData["name"]),
+					resource.TestCheckResourceAttr(dataSourceName, "groups.0.display_name", testData["name"]),
+					resource.TestCheckResourceAttr(dataSourceName, "groups.0.members.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "groups.0.members.0.value", testData["email"]),
+					resource.TestCheckResourceAttr(dataSourceName, "groups.0.meta.resourceType", "Group"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,273 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+// SCIMListPageSize is the number of resources requested per page when
+// listing SCIM users or groups.
+const SCIMListPageSize = 100
+
+type SCIMListResponseAPIModel[T any] struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int      `json:"totalResults"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	StartIndex   int      `json:"startIndex"`
+	Resources    []T      `json:"Resources"`
+}
+
+// listSCIMResources fetches every resource matching the optional SCIM filter
+// expression, following startIndex/count pagination until totalResults is reached.
+func listSCIMResources[T any](ctx context.Context, client *resty.Client, endpoint, filter string) ([]T, error) {
+	var resources []T
+
+	startIndex := 1
+	for {
+		var page SCIMListResponseAPIModel[T]
+		var scimErr SCIMErrorAPIModel
+
+		request := client.R().SetContext(ctx).
+			SetQueryParams(map[string]string{
+				"startIndex": strconv.Itoa(startIndex),
+				"count":      strconv.Itoa(SCIMListPageSize),
+			}).
+			SetResult(&page).
+			SetError(&scimErr)
+		if filter != "" {
+			request.SetQueryParam("filter", filter)
+		}
+
+		response, err := request.Get(endpoint)
+		if err != nil {
+			return nil, err
+		}
+
+		if response.IsError() {
+			if scimErr.Detail != "" {
+				return nil, fmt.Errorf("%s", scimErr.Detail)
+			}
+			return nil, fmt.Errorf("%s", response.String())
+		}
+
+		resources = append(resources, page.Resources...)
+
+		if len(page.Resources) == 0 || len(resources) >= page.TotalResults {
+			break
+		}
+
+		startIndex += len(page.Resources)
+	}
+
+	return resources, nil
+}
+
+var scimUsersDataSourceAttributeTypes map[string]attr.Type = map[string]attr.Type{
+	"username":     types.StringType,
+	"active":       types.BoolType,
+	"name":         types.ObjectType{AttrTypes: SCIMUserNameResourceModelAttributeType},
+	"display_name": types.StringType,
+	"external_id":  types.StringType,
+	"emails":       types.SetType{ElemType: types.ObjectType{AttrTypes: SCIMUserEmailResourceModelAttributeType}},
+	"enterprise":   types.ObjectType{AttrTypes: SCIMEnterpriseUserResourceModelAttributeType},
+	"groups":       types.SetType{ElemType: types.ObjectType{AttrTypes: SCIMUserGroupResourceModelAttributeType}},
+	"meta":         types.MapType{ElemType: types.StringType},
+}
+
+func NewSCIMUsersDataSource() datasource.DataSource {
+	return &SCIMUsersDataSource{
+		TypeName: "platform_scim_users",
+	}
+}
+
+type SCIMUsersDataSource struct {
+	ProviderData util.ProviderMetadata
+	TypeName     string
+}
+
+type SCIMUsersDataSourceModel struct {
+	Filter types.String `tfsdk:"filter"`
+	Users  types.List   `tfsdk:"users"`
+}
+
+func (d *SCIMUsersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = d.TypeName
+}
+
+var scimFilterAttribute = schema.StringAttribute{
+	Optional: true,
+	Validators: []validator.String{
+		stringvalidator.LengthAtLeast(1),
+	},
+	MarkdownDescription: "SCIM filter expression as defined in [RFC 7644](https://datatracker.ietf.org/doc/html/rfc7644#section-3.4.2.2), e.g. `userName sw \"svc-\"`. When not set, all resources are returned.",
+}
+
+func (d *SCIMUsersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"filter": scimFilterAttribute,
+			"users": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"username": schema.StringAttribute{
+							Computed: true,
+						},
+						"active": schema.BoolAttribute{
+							Computed: true,
+						},
+						"name": schema.SingleNestedAttribute{
+							Attributes: map[string]schema.Attribute{
+								"formatted": schema.StringAttribute{
+									Computed: true,
+								},
+								"given_name": schema.StringAttribute{
+									Computed: true,
+								},
+								"middle_name": schema.StringAttribute{
+									Computed: true,
+								},
+								"family_name": schema.StringAttribute{
+									Computed: true,
+								},
+							},
+							Computed: true,
+						},
+						"display_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"external_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"emails": schema.SetNestedAttribute{
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"value": schema.StringAttribute{
+										Computed: true,
+									},
+									"primary": schema.BoolAttribute{
+										Computed: true,
+									},
+								},
+							},
+							Computed: true,
+						},
+						"enterprise": schema.SingleNestedAttribute{
+							Attributes: map[string]schema.Attribute{
+								"employee_number": schema.StringAttribute{
+									Computed: true,
+								},
+								"department": schema.StringAttribute{
+									Computed: true,
+								},
+								"manager": schema.StringAttribute{
+									Computed: true,
+								},
+							},
+							Computed: true,
+						},
+						"groups": schema.SetNestedAttribute{
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"value": schema.StringAttribute{
+										Computed: true,
+									},
+								},
+							},
+							Computed: true,
+						},
+						"meta": schema.MapAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+				Computed:            true,
+				MarkdownDescription: "SCIM users matching `filter`, in the same shape as the `platform_scim_user` resource.",
+			},
+		},
+		MarkdownDescription: "Provides a JFrog [SCIM](https://jfrog.com/help/r/jfrog-platform-administration-documentation/scim) users data source to look up users provisioned with the SCIM protocol.",
+	}
+}
+
+func (d *SCIMUsersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+func (d *SCIMUsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	go util.SendUsage(ctx, d.ProviderData.Client.R(), d.ProviderData.ProductId, fmt.Sprintf("DataSource/%s/READ", d.TypeName))
+
+	var data SCIMUsersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	users, err := listSCIMResources[SCIMUserAPIModel](ctx, d.ProviderData.Client, SCIMUsersEndpoint, data.Filter.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Data Source",
+			"An unexpected error occurred while listing SCIM users. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+		return
+	}
+
+	userValues := make([]attr.Value, 0, len(users))
+	for _, user := range users {
+		var model SCIMUserResourceModel
+		resp.Diagnostics.Append(model.fromAPIModel(ctx, &user)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		userValue, ds := types.ObjectValueFrom(ctx, scimUsersDataSourceAttributeTypes, model)
+		resp.Diagnostics.Append(ds...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		userValues = append(userValues, userValue)
+	}
+
+	usersList, ds := types.ListValue(
+		types.ObjectType{AttrTypes: scimUsersDataSourceAttributeTypes},
+		userValues,
+	)
+	resp.Diagnostics.Append(ds...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Users = usersList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
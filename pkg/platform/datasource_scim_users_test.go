@@ -0,0 +1,70 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccSCIMUsersDataSource_filter(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("test-scim-user", "platform_scim_user")
+	dataSourceName := fmt.Sprintf("data.platform_scim_users.%s", name)
+
+	temp := `
+	resource "platform_scim_user" "{{ .name }}" {
+		username     = "{{ .email }}"
+		display_name = "{{ .name }}"
+		emails = [{
+			value = "{{ .email }}"
+			primary = true
+		}]
+	}
+
+	data "platform_scim_users" "{{ .name }}" {
+		filter = "userName eq \"${platform_scim_user.{{ .name }}.username}\""
+	}`
+
+	testData := map[string]string{
+		"name":  name,
+		"email": fmt.Sprintf("%s@tempurl.org", name),
+	}
+
+	config := util.ExecuteTemplate(name, temp, testData)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(),
+		CheckDestroy:             testAccSCIMUserDestroy(fqrn),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "users.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "users.0.username", testData["email"]),
+					resource.TestCheckResourceAttr(dataSourceName, "users.0.display_name", testData["name"]),
+					resource.TestCheckResourceAttr(dataSourceName, "users.0.active", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "users.0.emails.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "users.0.emails.0.value", testData["email"]),
+					resource.TestCheckResourceAttr(dataSourceName, "users.0.meta.resourceType", "User"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,249 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+const (
+	xrayVersionEndpoint    = "/xray/api/v1/system/version"
+	workersVersionEndpoint = "/worker/api/v1/system/version"
+	topologyHealthEndpoint = "/router/api/v1/topology/health"
+)
+
+func NewSystemInfoDataSource(platformData *PlatformProviderData) datasource.DataSource {
+	return &SystemInfoDataSource{
+		TypeName:     "platform_system_info",
+		PlatformData: platformData,
+	}
+}
+
+type SystemInfoDataSource struct {
+	ProviderData util.ProviderMetadata
+	PlatformData *PlatformProviderData
+	TypeName     string
+}
+
+type SystemInfoDataSourceModel struct {
+	ArtifactoryVersion types.String          `tfsdk:"artifactory_version"`
+	AccessVersion      types.String          `tfsdk:"access_version"`
+	XrayVersion        types.String          `tfsdk:"xray_version"`
+	WorkersVersion     types.String          `tfsdk:"workers_version"`
+	SaaS               types.Bool            `tfsdk:"saas"`
+	LicenseType        types.String          `tfsdk:"license_type"`
+	BaseURL            types.String          `tfsdk:"base_url"`
+	Nodes              []SystemInfoNodeModel `tfsdk:"nodes"`
+	Services           []types.String        `tfsdk:"services"`
+}
+
+type SystemInfoNodeModel struct {
+	ID       types.String   `tfsdk:"id"`
+	Services []types.String `tfsdk:"services"`
+}
+
+type xrayVersionAPIModel struct {
+	Version string `json:"xray_version"`
+}
+
+type workersVersionAPIModel struct {
+	Version string `json:"version"`
+}
+
+type topologyHealthAPIModel struct {
+	Services []struct {
+		ServiceID string `json:"service_id"`
+		NodeID    string `json:"node_id"`
+	} `json:"services"`
+}
+
+func (d *SystemInfoDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = d.TypeName
+}
+
+func (d *SystemInfoDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"artifactory_version": schema.StringAttribute{
+				Computed:    true,
+				Description: "Artifactory version. Empty when it could not be detected.",
+			},
+			"access_version": schema.StringAttribute{
+				Computed:    true,
+				Description: "Access version. Empty when it could not be detected.",
+			},
+			"xray_version": schema.StringAttribute{
+				Computed:    true,
+				Description: "Xray version. Not set when Xray is not installed or not reachable.",
+			},
+			"workers_version": schema.StringAttribute{
+				Computed:    true,
+				Description: "Workers service version. Not set when the Workers service is not enabled or not reachable.",
+			},
+			"saas": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the instance is a JFrog SaaS instance rather than a self-hosted one: the `saas` attribute of the provider when set, otherwise whether the host of the provider `url` is a `*.jfrog.io` host.",
+			},
+			"license_type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Type (edition) of the installed license, e.g. `Enterprise Plus`. Not set when the license cannot be read, e.g. on SaaS instances.",
+			},
+			"base_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "Base URL of the JFrog Platform, as configured in the provider.",
+			},
+			"nodes": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the node.",
+						},
+						"services": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+							Description: "Types of the services running on the node, sorted.",
+						},
+					},
+				},
+				Computed:    true,
+				Description: "Nodes of the cluster, sorted by ID. Not set when the topology of the cluster cannot be read.",
+			},
+			"services": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Types of the services enabled on the cluster, sorted, e.g. `jfrt` for Artifactory, `jfac` for Access or `jfxr` for Xray. Not set when the topology of the cluster cannot be read.",
+			},
+		},
+		MarkdownDescription: "Provides a data source with information about the JFrog Platform instance: product versions, SaaS or self-hosted, license type, cluster nodes and enabled services. It can be used to include or skip resources depending on the instance, e.g. `platform_license` on self-hosted instances only.",
+	}
+}
+
+func (d *SystemInfoDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+// getOptional gets an optional endpoint, e.g. of a service which may not be
+// installed, and reports whether the result was read.
+func (d *SystemInfoDataSource) getOptional(ctx context.Context, endpoint string, result any) bool {
+	response, err := d.ProviderData.Client.R().SetContext(ctx).
+		SetResult(result).
+		Get(endpoint)
+	if err != nil {
+		tflog.Debug(ctx, "unable to read system info", map[string]any{
+			"endpoint": endpoint,
+			"error":    err.Error(),
+		})
+		return false
+	}
+
+	if response.IsError() {
+		tflog.Debug(ctx, "unable to read system info", map[string]any{
+			"endpoint": endpoint,
+			"status":   response.StatusCode(),
+		})
+		return false
+	}
+
+	return true
+}
+
+// optionalString returns value, or null when it was not read.
+func optionalString(ok bool, value string) types.String {
+	if !ok || value == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(value)
+}
+
+// serviceType returns the type of a router service ID, e.g. "jfrt" for
+// "jfrt@01h7...".
+func serviceType(serviceID string) string {
+	serviceType, _, _ := strings.Cut(serviceID, "@")
+	return serviceType
+}
+
+func toStringValues(values []string) []types.String {
+	result := make([]types.String, 0, len(values))
+	for _, value := range values {
+		result = append(result, types.StringValue(value))
+	}
+
+	return result
+}
+
+func (d *SystemInfoDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	go util.SendUsage(ctx, d.ProviderData.Client.R(), d.ProviderData.ProductId, fmt.Sprintf("DataSource/%s/READ", d.TypeName))
+
+	data := SystemInfoDataSourceModel{
+		ArtifactoryVersion: types.StringValue(d.ProviderData.ArtifactoryVersion),
+		AccessVersion:      types.StringValue(d.ProviderData.AccessVersion),
+		SaaS:               types.BoolValue(d.PlatformData.isSaaSInstance(d.ProviderData.Client)),
+		BaseURL:            types.StringValue(strings.TrimSuffix(d.ProviderData.Client.BaseURL, "/")),
+	}
+
+	var xray xrayVersionAPIModel
+	data.XrayVersion = optionalString(d.getOptional(ctx, xrayVersionEndpoint, &xray), xray.Version)
+
+	var workers workersVersionAPIModel
+	data.WorkersVersion = optionalString(d.getOptional(ctx, workersVersionEndpoint, &workers), workers.Version)
+
+	var license licenseAPIGetModel
+	data.LicenseType = optionalString(d.getOptional(ctx, licenseGetEndpoint, &license), license.Type)
+
+	var topology topologyHealthAPIModel
+	if d.getOptional(ctx, topologyHealthEndpoint, &topology) {
+		nodeServices := map[string][]string{}
+		services := []string{}
+		for _, service := range topology.Services {
+			t := serviceType(service.ServiceID)
+			if !slices.Contains(nodeServices[service.NodeID], t) {
+				nodeServices[service.NodeID] = append(nodeServices[service.NodeID], t)
+			}
+			if !slices.Contains(services, t) {
+				services = append(services, t)
+			}
+		}
+
+		data.Nodes = make([]SystemInfoNodeModel, 0, len(nodeServices))
+		for _, id := range slices.Sorted(maps.Keys(nodeServices)) {
+			slices.Sort(nodeServices[id])
+			data.Nodes = append(data.Nodes, SystemInfoNodeModel{
+				ID:       types.StringValue(id),
+				Services: toStringValues(nodeServices[id]),
+			})
+		}
+
+		slices.Sort(services)
+		data.Services = toStringValues(services)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
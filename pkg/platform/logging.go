@@ -0,0 +1,225 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// httpLogSubsystem is the tflog subsystem of the HTTP calls, whose level can
+// be set with TF_LOG_PROVIDER_PLATFORM_HTTP.
+const httpLogSubsystem = "http"
+
+const redacted = "<REDACTED>"
+
+// sensitiveHeaders are the headers whose values are never logged.
+var sensitiveHeaders = []string{
+	"Authorization",
+	"Cookie",
+	"Set-Cookie",
+	"X-JFrog-Art-Api",
+}
+
+// sensitiveFields are the JSON fields whose values are never logged, compared
+// case insensitively at any depth: worker secrets, Crowd and LDAP passwords,
+// license keys, SAML certificates, reverse proxy TLS keys and certificates,
+// and tokens.
+var sensitiveFields = []string{
+	"access_token",
+	"certificate",
+	"licenseKey",
+	"manager_password",
+	"password",
+	"refresh_token",
+	"secrets",
+	"sslCertificate",
+	"sslKey",
+	"token",
+}
+
+// redactJSON returns the JSON body with the values of the sensitive fields
+// replaced, and false when the body is not JSON.
+func redactJSON(body []byte) (string, bool) {
+	var value any
+	if err := json.Unmarshal(body, &value); err != nil {
+		return "", false
+	}
+
+	var redactedBody bytes.Buffer
+	encoder := json.NewEncoder(&redactedBody)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(redactValue(value)); err != nil {
+		return "", false
+	}
+
+	return strings.TrimSuffix(redactedBody.String(), "\n"), true
+}
+
+func redactValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for field, fieldValue := range v {
+			if slices.ContainsFunc(sensitiveFields, func(sensitive string) bool {
+				return strings.EqualFold(field, sensitive)
+			}) {
+				v[field] = redacted
+				continue
+			}
+			v[field] = redactValue(fieldValue)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+	}
+
+	return value
+}
+
+// redactHeaders returns the headers as log field, with the values of the
+// sensitive headers replaced.
+func redactHeaders(headers http.Header) map[string]string {
+	fields := make(map[string]string, len(headers))
+	for name, values := range headers {
+		if slices.ContainsFunc(sensitiveHeaders, func(sensitive string) bool {
+			return strings.EqualFold(name, sensitive)
+		}) {
+			fields[name] = redacted
+			continue
+		}
+		fields[name] = strings.Join(values, ", ")
+	}
+
+	return fields
+}
+
+// bodyField returns the body as log field: redacted JSON, or only its size
+// and type as other bodies, e.g. XML or plain text, can not be redacted.
+func bodyField(body []byte, contentType string) any {
+	if len(body) == 0 {
+		return ""
+	}
+
+	if mediaType, _, _ := mime.ParseMediaType(contentType); mediaType == "" || strings.HasSuffix(mediaType, "json") {
+		if redactedBody, ok := redactJSON(body); ok {
+			return redactedBody
+		}
+	}
+
+	return map[string]any{
+		"content_type": contentType,
+		"size":         len(body),
+	}
+}
+
+// loggingTransport logs every HTTP call in the http subsystem of the logger
+// of the request context: method, path, status, latency and request ID at
+// DEBUG level, and the redacted headers and bodies at TRACE level.
+type loggingTransport struct {
+	// ctx is the provider configuration context, logging the requests sent
+	// without a context, e.g. by the shared provider library.
+	ctx  context.Context
+	next http.RoundTripper
+}
+
+func newLoggingTransport(ctx context.Context, next http.RoundTripper) *loggingTransport {
+	return &loggingTransport{
+		ctx:  ctx,
+		next: next,
+	}
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := tflog.NewSubsystem(requestContext(req.Context(), t.ctx), httpLogSubsystem, tflog.WithRootFields())
+
+	var requestBody []byte
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			requestBody, _ = io.ReadAll(body)
+			body.Close()
+		}
+	}
+
+	tflog.SubsystemTrace(ctx, httpLogSubsystem, "sending request", map[string]any{
+		"method":  req.Method,
+		"path":    req.URL.Path,
+		"headers": redactHeaders(req.Header),
+		"body":    bodyField(requestBody, req.Header.Get("Content-Type")),
+	})
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	latency := time.Since(start)
+
+	fields := map[string]any{
+		"method":     req.Method,
+		"path":       req.URL.Path,
+		"latency_ms": latency.Milliseconds(),
+	}
+
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.SubsystemDebug(ctx, httpLogSubsystem, "request failed", fields)
+		return resp, err
+	}
+
+	fields["status"] = resp.StatusCode
+	fields["request_id"] = resp.Header.Get("X-Request-Id")
+	tflog.SubsystemDebug(ctx, httpLogSubsystem, "received response", fields)
+
+	// The body is logged once read and closed by the client
+	body := &loggedBody{ReadCloser: resp.Body}
+	body.log = sync.OnceFunc(func() {
+		tflog.SubsystemTrace(ctx, httpLogSubsystem, "response body", map[string]any{
+			"method":     req.Method,
+			"path":       req.URL.Path,
+			"request_id": fields["request_id"],
+			"headers":    redactHeaders(resp.Header),
+			"body":       bodyField(body.buf.Bytes(), resp.Header.Get("Content-Type")),
+		})
+	})
+	resp.Body = body
+
+	return resp, nil
+}
+
+// loggedBody keeps a copy of the response body read, to log it on close.
+type loggedBody struct {
+	io.ReadCloser
+	buf bytes.Buffer
+	log func()
+}
+
+func (b *loggedBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.buf.Write(p[:n])
+	return n, err
+}
+
+func (b *loggedBody) Close() error {
+	b.log()
+	return b.ReadCloser.Close()
+}
@@ -0,0 +1,141 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+// secret is the value of every secret field of the API models below, which
+// must never appear in a redacted body.
+const secret = "s3cr3t-value"
+
+func TestRedactJSON_secretBearingAPIModels(t *testing.T) {
+	models := map[string]any{
+		"crowd settings": CrowdSettingsAPIModel{
+			ServerURL: "https://crowd.example.com",
+			Password:  secret,
+		},
+		"HA licenses": []licenseAPIPostRequestModel{
+			{Key: secret},
+		},
+		"LDAP setting": LDAPSettingAPIModel{
+			Key:             "ldap",
+			ManagerPassword: secret,
+		},
+		"license": licenseAPIPostRequestModel{
+			Key: secret,
+		},
+		"reverse proxy": reverseProxyAPIModel{
+			Key:            "nginx",
+			SslKey:         secret,
+			SslCertificate: secret,
+		},
+		"SAML settings": SAMLSettingsAPIModel{
+			Name:        "okta",
+			Certificate: secret,
+		},
+		"workers service": WorkersServiceAPIModel{
+			Key: "worker",
+			Secrets: []secretAPIModel{
+				{Key: "token", Value: secret},
+			},
+		},
+	}
+
+	for name, model := range models {
+		t.Run(name, func(t *testing.T) {
+			body, err := json.Marshal(model)
+			if err != nil {
+				t.Fatalf("failed to marshal %s: %v", name, err)
+			}
+
+			redactedBody, ok := redactJSON(body)
+			if !ok {
+				t.Fatalf("%s body is not redacted as JSON", name)
+			}
+
+			if strings.Contains(redactedBody, secret) {
+				t.Errorf("%s body is logged with a secret: %s", name, redactedBody)
+			}
+
+			if !strings.Contains(redactedBody, redacted) {
+				t.Errorf("%s body has no redacted field: %s", name, redactedBody)
+			}
+		})
+	}
+}
+
+func TestRedactHeaders(t *testing.T) {
+	headers := http.Header{
+		"Authorization": []string{"Bearer " + secret},
+		"Content-Type":  []string{"application/json"},
+	}
+
+	fields := redactHeaders(headers)
+
+	if fields["Authorization"] != redacted {
+		t.Errorf("Authorization header is logged as %q", fields["Authorization"])
+	}
+
+	if fields["Content-Type"] != "application/json" {
+		t.Errorf("Content-Type header is logged as %q", fields["Content-Type"])
+	}
+}
+
+func TestBodyField_notJSON(t *testing.T) {
+	field := bodyField([]byte(secret), "text/plain")
+
+	if s, ok := field.(string); ok && strings.Contains(s, secret) {
+		t.Errorf("plain text body is logged: %s", s)
+	}
+}
+
+func TestLoggingTransport_requestContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var configureLog, requestLog bytes.Buffer
+	configureCtx := tflogtest.RootLogger(context.Background(), &configureLog)
+	requestCtx := tflogtest.RootLogger(context.Background(), &requestLog)
+
+	client := resty.New().SetBaseURL(server.URL)
+	configureClient(configureCtx, client, clientConfig{})
+
+	if _, err := client.R().SetContext(requestCtx).Get("/with-context"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.R().Get("/without-context"); err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(requestLog.String(), "/with-context") || strings.Contains(requestLog.String(), "/without-context") {
+		t.Errorf("request context log: %s", requestLog.String())
+	}
+	if !strings.Contains(configureLog.String(), "/without-context") || strings.Contains(configureLog.String(), "/with-context") {
+		t.Errorf("provider configuration context log: %s", configureLog.String())
+	}
+}
@@ -16,10 +16,20 @@ package platform
 
 import (
 	"context"
+	"fmt"
+	"maps"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/jfrog/terraform-provider-shared/util"
 )
 
@@ -27,9 +37,19 @@ import (
 var Version = "2.0.0"
 
 var _ provider.Provider = &PlatformProvider{}
+var _ provider.ProviderWithValidateConfig = &PlatformProvider{}
 
 type PlatformProvider struct {
 	util.JFrogProvider
+	Data PlatformProviderData
+}
+
+// PlatformProviderData is the configuration of the provider read by the
+// resources besides the util.ProviderMetadata passed to their Configure, which
+// the shared resources expect as is.
+type PlatformProviderData struct {
+	// SaaS is the saas attribute of the provider, null when not set.
+	SaaS types.Bool
 }
 
 func NewProvider() func() provider.Provider {
@@ -44,27 +64,170 @@ func NewProvider() func() provider.Provider {
 	}
 }
 
+// platformProviderModel is the configuration of the provider: the shared JFrog
+// provider attributes, and the attributes of the client configuration.
+type platformProviderModel struct {
+	util.JFrogProviderModel
+	MaxRetries            types.Int64 `tfsdk:"max_retries"`
+	MinBackoffSeconds     types.Int64 `tfsdk:"min_backoff_seconds"`
+	MaxBackoffSeconds     types.Int64 `tfsdk:"max_backoff_seconds"`
+	MaxConcurrentRequests types.Int64 `tfsdk:"max_concurrent_requests"`
+	SaaS                  types.Bool  `tfsdk:"saas"`
+}
+
+// platformProviderAttributes are the attributes the provider adds to the shared
+// JFrog provider schema.
+var platformProviderAttributes = map[string]schema.Attribute{
+	"max_retries": schema.Int64Attribute{
+		Optional: true,
+		Validators: []validator.Int64{
+			int64validator.Between(0, 100),
+		},
+		MarkdownDescription: fmt.Sprintf("Maximum number of retries of a request on network errors and on `429`, `502`, `503` and `504` responses. Only `GET`, `PUT` and `DELETE` requests, and the `POST` requests which are safe to send again, are retried. Set to `0` to disable the retries. Default: `%d`.", defaultMaxRetries),
+	},
+	"min_backoff_seconds": schema.Int64Attribute{
+		Optional: true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+		MarkdownDescription: fmt.Sprintf("Minimum wait time in seconds before a retry. The wait time grows exponentially with jitter between the retries, unless the response has a `Retry-After` header. Default: `%d`.", defaultMinBackoffSeconds),
+	},
+	"max_backoff_seconds": schema.Int64Attribute{
+		Optional: true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+		MarkdownDescription: fmt.Sprintf("Maximum wait time in seconds before a retry, including the wait time requested by a `Retry-After` header. Default: `%d`.", defaultMaxBackoffSeconds),
+	},
+	"max_concurrent_requests": schema.Int64Attribute{
+		Optional: true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+		MarkdownDescription: "Maximum number of requests sent to the JFrog Platform at the same time, e.g. to stay under the rate limits of a SaaS instance when applying large plans. Unlimited by default.",
+	},
+	"saas": schema.BoolAttribute{
+		Optional:            true,
+		MarkdownDescription: "Whether the JFrog Platform is a JFrog SaaS (Cloud) instance rather than a self-hosted one, which changes how some resources are applied, e.g. `enable` of `platform_saml_settings`. By default, an instance is SaaS when the host of `url` is a `*.jfrog.io` host. Set it for SaaS instances reached through a custom domain, a proxy or a private link.",
+	},
+}
+
+func (p *PlatformProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
+	p.JFrogProvider.Schema(ctx, req, resp)
+
+	maps.Copy(resp.Schema.Attributes, platformProviderAttributes)
+}
+
+func (p *PlatformProvider) ValidateConfig(ctx context.Context, req provider.ValidateConfigRequest, resp *provider.ValidateConfigResponse) {
+	var config platformProviderModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.MinBackoffSeconds.IsNull() && !config.MinBackoffSeconds.IsUnknown() &&
+		!config.MaxBackoffSeconds.IsNull() && !config.MaxBackoffSeconds.IsUnknown() &&
+		config.MinBackoffSeconds.ValueInt64() > config.MaxBackoffSeconds.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("min_backoff_seconds"),
+			"Invalid Attribute Configuration",
+			"min_backoff_seconds must not be greater than max_backoff_seconds.",
+		)
+	}
+}
+
+// Configure configures the shared JFrog provider with its own attributes, then
+// applies the client configuration to the client it built.
+func (p *PlatformProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var config platformProviderModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var jfrogSchema provider.SchemaResponse
+	p.JFrogProvider.Schema(ctx, provider.SchemaRequest{}, &jfrogSchema)
+
+	var attributes map[string]tftypes.Value
+	if err := req.Config.Raw.As(&attributes); err != nil {
+		resp.Diagnostics.AddError("Unable to Read Provider Configuration", err.Error())
+		return
+	}
+	for name := range platformProviderAttributes {
+		delete(attributes, name)
+	}
+
+	jfrogReq := req
+	jfrogReq.Config = tfsdk.Config{
+		Raw:    tftypes.NewValue(jfrogSchema.Schema.Type().TerraformType(ctx), attributes),
+		Schema: jfrogSchema.Schema,
+	}
+
+	p.JFrogProvider.Configure(ctx, jfrogReq, resp)
+	if resp.Diagnostics.HasError() || p.Meta.Client == nil {
+		return
+	}
+
+	clientConfig := clientConfig{
+		MaxRetries:            int(int64OrDefault(config.MaxRetries, defaultMaxRetries)),
+		MinBackoff:            time.Duration(int64OrDefault(config.MinBackoffSeconds, defaultMinBackoffSeconds)) * time.Second,
+		MaxBackoff:            time.Duration(int64OrDefault(config.MaxBackoffSeconds, defaultMaxBackoffSeconds)) * time.Second,
+		MaxConcurrentRequests: int(int64OrDefault(config.MaxConcurrentRequests, 0)),
+	}
+	// Only one of the backoff attributes may be set, beyond the default of the other
+	clientConfig.MaxBackoff = max(clientConfig.MaxBackoff, clientConfig.MinBackoff)
+
+	configureClient(ctx, p.Meta.Client, clientConfig)
+
+	p.Data.SaaS = config.SaaS
+}
+
+// int64OrDefault returns the value of an optional provider attribute, or the
+// default when it is not set or not known.
+func int64OrDefault(value types.Int64, defaultValue int64) int64 {
+	if value.IsNull() || value.IsUnknown() {
+		return defaultValue
+	}
+
+	return value.ValueInt64()
+}
+
 func (p *PlatformProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
-		// NewDataSource,
+		NewSCIMUsersDataSource,
+		NewSCIMGroupsDataSource,
+		NewSAMLSettingsListDataSource,
+		NewLicenseDataSource,
+		NewRoleActionsDataSource,
+		NewAWSIAMRolesDataSource,
+		NewCapabilitiesDataSource,
+		func() datasource.DataSource { return NewSystemInfoDataSource(&p.Data) },
 	}
 }
 
 func (p *PlatformProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewAWSIAMRoleResource,
+		NewAWSIAMRoleMappingsResource,
+		NewAzureManagedIdentityResource,
+		NewGCPServiceAccountResource,
 		NewCrowdSettingsResource,
 		NewLicenseResource,
+		NewHALicensesResource,
 		NewGlobalRoleResource,
+		NewGlobalEnvironmentResource,
+		NewRoleAssignmentResource,
 		NewGroupResource,
 		NewGroupMembersResource,
 		NewHTTPSSOSettingsResource,
+		NewLDAPSettingResource,
+		NewLDAPGroupSettingResource,
 		NewOIDCConfigurationResource,
 		NewOIDCIdentityMappingResource,
 		NewMyJFrogIPAllowListResource,
 		NewPermissionResource,
 		NewReverseProxyResource,
-		NewSAMLSettingsResource,
+		func() resource.Resource { return NewSAMLSettingsResource(&p.Data) },
 		NewSCIMUserResource,
 		NewSCIMGroupResource,
 		NewWorkerServiceResource,
@@ -0,0 +1,102 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+)
+
+func TestAccProvider_client_config(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("test-client-config", "data.platform_capabilities")
+
+	config := fmt.Sprintf(`
+	provider "platform" {
+		max_retries             = 3
+		min_backoff_seconds     = 1
+		max_backoff_seconds     = 10
+		max_concurrent_requests = 2
+	}
+
+	data "platform_capabilities" "%s" {}`, name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(fqrn, "artifactory_version", regexp.MustCompile(`^\d+\.\d+\.\d+`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProvider_invalid_backoff(t *testing.T) {
+	_, _, name := testutil.MkNames("test-client-config", "data.platform_capabilities")
+
+	config := fmt.Sprintf(`
+	provider "platform" {
+		min_backoff_seconds = 20
+		max_backoff_seconds = 10
+	}
+
+	data "platform_capabilities" "%s" {}`, name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`min_backoff_seconds must not be greater than max_backoff_seconds`),
+			},
+		},
+	})
+}
+
+func TestAccProvider_saas(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("test-saas", "data.platform_system_info")
+
+	config := func(saas bool) string {
+		return fmt.Sprintf(`
+		provider "platform" {
+			saas = %t
+		}
+
+		data "platform_system_info" "%s" {}`, saas, name)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: config(true),
+				Check:  resource.TestCheckResourceAttr(fqrn, "saas", "true"),
+			},
+			{
+				Config: config(false),
+				Check:  resource.TestCheckResourceAttr(fqrn, "saas", "false"),
+			},
+		},
+	})
+}
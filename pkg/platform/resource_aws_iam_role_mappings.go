@@ -0,0 +1,454 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jfrog/terraform-provider-shared/util"
+	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
+)
+
+// awsIAMRoleMappingsConcurrency is the number of mappings updated or deleted
+// at the same time.
+const awsIAMRoleMappingsConcurrency = 8
+
+var _ resource.Resource = (*AWSIAMRoleMappingsResource)(nil)
+var _ resource.ResourceWithIdentity = (*AWSIAMRoleMappingsResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*AWSIAMRoleMappingsResource)(nil)
+
+func NewAWSIAMRoleMappingsResource() resource.Resource {
+	return &AWSIAMRoleMappingsResource{
+		TypeName: "platform_aws_iam_role_mappings",
+	}
+}
+
+type AWSIAMRoleMappingsResource struct {
+	ProviderData util.ProviderMetadata
+	TypeName     string
+}
+
+type AWSIAMRoleMappingsResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	Mappings types.Map    `tfsdk:"mappings"`
+}
+
+func (r *AWSIAMRoleMappingsResourceModel) setMappings(ctx context.Context, mappings map[string]string) (ds diag.Diagnostics) {
+	m, d := types.MapValueFrom(ctx, types.StringType, mappings)
+	if d.HasError() {
+		ds.Append(d...)
+		return
+	}
+
+	r.ID = types.StringValue(singletonImportID)
+	r.Mappings = m
+
+	return
+}
+
+// awsIAMRoleMappingsChanges are the changes between the current and the
+// desired mappings, each sorted by user name.
+type awsIAMRoleMappingsChanges struct {
+	Added   []string
+	Updated []string
+	Removed []string
+}
+
+func diffAWSIAMRoleMappings(current, desired map[string]string) (changes awsIAMRoleMappingsChanges) {
+	for _, username := range slices.Sorted(maps.Keys(desired)) {
+		role, ok := current[username]
+		if !ok {
+			changes.Added = append(changes.Added, username)
+		} else if role != desired[username] {
+			changes.Updated = append(changes.Updated, username)
+		}
+	}
+
+	for _, username := range slices.Sorted(maps.Keys(current)) {
+		if _, ok := desired[username]; !ok {
+			changes.Removed = append(changes.Removed, username)
+		}
+	}
+
+	return
+}
+
+// summary returns the changes as one line per kind of change.
+func (c awsIAMRoleMappingsChanges) summary() string {
+	var lines []string
+	for _, change := range []struct {
+		kind      string
+		usernames []string
+	}{
+		{"Added", c.Added},
+		{"Updated", c.Updated},
+		{"Removed", c.Removed},
+	} {
+		if len(change.usernames) > 0 {
+			lines = append(lines, fmt.Sprintf("%s %d: %s", change.kind, len(change.usernames), strings.Join(change.usernames, ", ")))
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// applyAWSIAMRoleMappings updates the mappings of the instance from current to
+// desired, with up to awsIAMRoleMappingsConcurrency requests at the same time.
+// It returns the mappings of the instance after the changes, which differ from
+// desired for the mappings that failed.
+func (r *AWSIAMRoleMappingsResource) applyAWSIAMRoleMappings(ctx context.Context, current, desired map[string]string) (map[string]string, awsIAMRoleMappingsChanges, []error) {
+	changes := diffAWSIAMRoleMappings(current, desired)
+
+	applied := maps.Clone(current)
+	var errs []error
+	var lock sync.Mutex
+
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, awsIAMRoleMappingsConcurrency)
+
+	run := func(username string, f func(string) error, onSuccess func(string)) {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			err := f(username)
+
+			lock.Lock()
+			defer lock.Unlock()
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", username, err))
+				return
+			}
+			onSuccess(username)
+		}()
+	}
+
+	put := func(username string) error {
+		return r.putAWSIAMRole(ctx, username, desired[username])
+	}
+	remove := func(username string) error {
+		return r.deleteAWSIAMRole(ctx, username)
+	}
+	onPut := func(username string) {
+		applied[username] = desired[username]
+	}
+	onDelete := func(username string) {
+		delete(applied, username)
+	}
+
+	for _, username := range changes.Added {
+		run(username, put, onPut)
+	}
+	for _, username := range changes.Updated {
+		run(username, put, onPut)
+	}
+	for _, username := range changes.Removed {
+		run(username, remove, onDelete)
+	}
+
+	wg.Wait()
+
+	slices.SortFunc(errs, func(a, b error) int {
+		return strings.Compare(a.Error(), b.Error())
+	})
+
+	return applied, changes, errs
+}
+
+func (r *AWSIAMRoleMappingsResource) putAWSIAMRole(ctx context.Context, username, iamRole string) error {
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
+		SetBody(AWSIAMRoleAPIModel{
+			Username: username,
+			IAMRole:  iamRole,
+		}).
+		Put(AWSIAMRolesEndpoint)
+	if err != nil {
+		return err
+	}
+
+	if response.IsError() {
+		return fmt.Errorf("%s", response.String())
+	}
+
+	return nil
+}
+
+func (r *AWSIAMRoleMappingsResource) deleteAWSIAMRole(ctx context.Context, username string) error {
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
+		SetPathParam("username", username).
+		Delete(AWSIAMRoleEndpoint)
+	if err != nil {
+		return err
+	}
+
+	// Return error if the HTTP status code is not 204 No Content or 404 Not Found
+	if response.IsError() && response.StatusCode() != http.StatusNotFound {
+		return fmt.Errorf("%s", response.String())
+	}
+
+	return nil
+}
+
+func (r *AWSIAMRoleMappingsResource) listMappings(ctx context.Context) (map[string]string, error) {
+	roles, err := listAWSIAMRoles(ctx, r.ProviderData.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	mappings := make(map[string]string, len(roles))
+	for _, role := range roles {
+		mappings[role.Username] = role.IAMRole
+	}
+
+	return mappings, nil
+}
+
+// applyMappings applies the desired mappings and reports the changes, returning
+// the mappings of the instance after the changes.
+func (r *AWSIAMRoleMappingsResource) applyMappings(ctx context.Context, current, desired map[string]string, operation string) (map[string]string, diag.Diagnostics) {
+	var ds diag.Diagnostics
+
+	applied, changes, errs := r.applyAWSIAMRoleMappings(ctx, current, desired)
+
+	if summary := changes.summary(); summary != "" {
+		failures := ""
+		if len(errs) > 0 {
+			failures = fmt.Sprintf(" %d of them failed, see the error.", len(errs))
+		}
+
+		ds.AddWarning(
+			"AWS IAM Role Mappings Changed",
+			fmt.Sprintf("The following AWS IAM role mappings were changed.%s\n\n%s", failures, summary),
+		)
+
+		tflog.Info(ctx, "AWS IAM role mappings changed", map[string]any{
+			"added":   changes.Added,
+			"updated": changes.Updated,
+			"removed": changes.Removed,
+			"failed":  len(errs),
+		})
+	}
+
+	if len(errs) > 0 {
+		messages := make([]string, 0, len(errs))
+		for _, err := range errs {
+			messages = append(messages, err.Error())
+		}
+
+		ds.AddError(
+			fmt.Sprintf("Unable to %s AWS IAM Role Mappings", operation),
+			fmt.Sprintf("Failed to apply %d mapping(s), the other mappings were applied:\n\n%s", len(errs), strings.Join(messages, "\n")),
+		)
+	}
+
+	return applied, ds
+}
+
+func (r *AWSIAMRoleMappingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.TypeName
+}
+
+func (r *AWSIAMRoleMappingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "Always `default`, as the mappings exist once per instance.",
+			},
+			"mappings": schema.MapAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.LengthAtLeast(1)),
+					mapvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(awsIAMRoleRegex, "Must follow the regex, \"^arn:aws:iam::\\d{12}:role/[\\w+=,.@:-]+$\""),
+					),
+				},
+				MarkdownDescription: "Map of JFrog Platform user name to AWS IAM role. The AWS IAM roles must follow the regex, \"^arn:aws:iam::\\d{12}:role/[\\w+=,.@:-]+$\". This is the complete list of mappings of the instance: mappings not in the map are removed.",
+			},
+		},
+		MarkdownDescription: "Provides a resource to authoritatively manage all the AWS IAM roles of JFrog platform users. You can use the AWS IAM roles for passwordless access to Amazon EKS. For more information, see [Passwordless Access for Amazon EKS](https://jfrog.com/help/r/jfrog-installation-setup-documentation/passwordless-access-for-amazon-eks).\n\n" +
+			"~>Mappings not declared in `mappings`, including the ones created outside Terraform, are removed. Do not use together with `platform_aws_iam_role`.\n\n" +
+			"->Only available for Artifactory 7.90.10 or later.",
+	}
+}
+
+func (r *AWSIAMRoleMappingsResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = singletonIdentitySchema
+}
+
+func (r *AWSIAMRoleMappingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+func (r *AWSIAMRoleMappingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(checkCapability(r.ProviderData, capabilityAWSIAMRole)...)
+}
+
+func (r *AWSIAMRoleMappingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	resp.Diagnostics.Append(checkCapability(r.ProviderData, capabilityAWSIAMRole)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan AWSIAMRoleMappingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var desired map[string]string
+	resp.Diagnostics.Append(plan.Mappings.ElementsAs(ctx, &desired, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The resource is authoritative, existing mappings not in the plan are removed
+	current, err := r.listMappings(ctx)
+	if err != nil {
+		utilfw.UnableToCreateResourceError(resp, err.Error())
+		return
+	}
+
+	applied, ds := r.applyMappings(ctx, current, desired, "Create")
+	resp.Diagnostics.Append(ds...)
+
+	resp.Diagnostics.Append(plan.setMappings(ctx, applied)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setSingletonIdentity(ctx, resp.Identity)...)
+}
+
+func (r *AWSIAMRoleMappingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var state AWSIAMRoleMappingsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mappings, err := r.listMappings(ctx)
+	if err != nil {
+		utilfw.UnableToRefreshResourceError(resp, err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(state.setMappings(ctx, mappings)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setSingletonIdentity(ctx, resp.Identity)...)
+}
+
+func (r *AWSIAMRoleMappingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	resp.Diagnostics.Append(checkCapability(r.ProviderData, capabilityAWSIAMRole)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan, state AWSIAMRoleMappingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var current, desired map[string]string
+	resp.Diagnostics.Append(state.Mappings.ElementsAs(ctx, &current, false)...)
+	resp.Diagnostics.Append(plan.Mappings.ElementsAs(ctx, &desired, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applied, ds := r.applyMappings(ctx, current, desired, "Update")
+	resp.Diagnostics.Append(ds...)
+
+	resp.Diagnostics.Append(plan.setMappings(ctx, applied)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setSingletonIdentity(ctx, resp.Identity)...)
+}
+
+func (r *AWSIAMRoleMappingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var state AWSIAMRoleMappingsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var current map[string]string
+	resp.Diagnostics.Append(state.Mappings.ElementsAs(ctx, &current, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applied, ds := r.applyMappings(ctx, current, map[string]string{}, "Delete")
+	resp.Diagnostics.Append(ds...)
+
+	// Keep the mappings that failed to be deleted in the state
+	if resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(state.setMappings(ctx, applied)...)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	}
+}
+
+func (r *AWSIAMRoleMappingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID != "" && req.ID != singletonImportID {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Use '%s' as import ID, got '%s'.", singletonImportID, req.ID),
+		)
+		return
+	}
+
+	importSingletonState(ctx, path.Root("id"), req, resp)
+}
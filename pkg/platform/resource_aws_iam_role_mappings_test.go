@@ -0,0 +1,141 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jfrog/terraform-provider-platform/v2/pkg/platform"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccAWSIAMRoleMappings_full(t *testing.T) {
+	id, fqrn, name := testutil.MkNames("test-aws-iam-role-mappings", "platform_aws_iam_role_mappings")
+
+	username1 := fmt.Sprintf("dummy_user%d_1", id)
+	username2 := fmt.Sprintf("dummy_user%d_2", id)
+
+	temp := `
+	resource "artifactory_managed_user" "{{ .username1 }}" {
+		name     = "{{ .username1 }}"
+		email    = "{{ .username1 }}@test.com"
+		password = "Passsw0rd!12"
+	}
+
+	resource "artifactory_managed_user" "{{ .username2 }}" {
+		name     = "{{ .username2 }}"
+		email    = "{{ .username2 }}@test.com"
+		password = "Passsw0rd!12"
+	}
+
+	resource "platform_aws_iam_role_mappings" "{{ .name }}" {
+		mappings = {
+			(artifactory_managed_user.{{ .username1 }}.name) = "arn:aws:iam::000000000000:role/{{ .role1 }}"
+			{{- if .role2 }}
+			(artifactory_managed_user.{{ .username2 }}.name) = "arn:aws:iam::000000000000:role/{{ .role2 }}"
+			{{- end }}
+		}
+	}
+
+	data "platform_aws_iam_roles" "{{ .name }}" {
+		depends_on = [platform_aws_iam_role_mappings.{{ .name }}]
+	}`
+
+	config := util.ExecuteTemplate(name, temp, map[string]string{
+		"name":      name,
+		"username1": username1,
+		"username2": username2,
+		"role1":     "example-1",
+		"role2":     "example-2",
+	})
+
+	updatedConfig := util.ExecuteTemplate(name, temp, map[string]string{
+		"name":      name,
+		"username1": username1,
+		"username2": username2,
+		"role1":     "example-3",
+		"role2":     "",
+	})
+
+	dataSourceFqrn := fmt.Sprintf("data.platform_aws_iam_roles.%s", name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"artifactory": {
+				Source: "jfrog/artifactory",
+			},
+		},
+		ProtoV6ProviderFactories: testAccProviders(),
+		CheckDestroy:             testAccAWSIAMRoleMappingsDestroy(username1, username2),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "id", "default"),
+					resource.TestCheckResourceAttr(fqrn, "mappings.%", "2"),
+					resource.TestCheckResourceAttr(fqrn, fmt.Sprintf("mappings.%s", username1), "arn:aws:iam::000000000000:role/example-1"),
+					resource.TestCheckResourceAttr(fqrn, fmt.Sprintf("mappings.%s", username2), "arn:aws:iam::000000000000:role/example-2"),
+					resource.TestCheckResourceAttr(dataSourceFqrn, "iam_roles.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceFqrn, fmt.Sprintf("mappings.%s", username1), "arn:aws:iam::000000000000:role/example-1"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "mappings.%", "1"),
+					resource.TestCheckResourceAttr(fqrn, fmt.Sprintf("mappings.%s", username1), "arn:aws:iam::000000000000:role/example-3"),
+					resource.TestCheckResourceAttr(dataSourceFqrn, "iam_roles.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceFqrn, "iam_roles.0.username", username1),
+				),
+			},
+			{
+				ResourceName:                         fqrn,
+				ImportState:                          true,
+				ImportStateId:                        "default",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "id",
+			},
+		},
+	})
+}
+
+func testAccAWSIAMRoleMappingsDestroy(usernames ...string) func(*terraform.State) error {
+	return func(s *terraform.State) error {
+		c := TestProvider.(*platform.PlatformProvider).Meta.Client
+
+		var roles []platform.AWSIAMRoleAPIModel
+		_, err := c.R().
+			SetResult(&roles).
+			Get(platform.AWSIAMRolesEndpoint)
+		if err != nil {
+			return err
+		}
+
+		for _, role := range roles {
+			for _, username := range usernames {
+				if role.Username == username {
+					return fmt.Errorf("error: AWS IAM role for username %s still exists", username)
+				}
+			}
+		}
+
+		return nil
+	}
+}
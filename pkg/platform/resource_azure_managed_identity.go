@@ -0,0 +1,67 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+const (
+	AzureManagedIdentitiesEndpoint = "access/api/v1/azure/managed_identity"
+	AzureManagedIdentityEndpoint   = "access/api/v1/azure/managed_identity/{username}"
+)
+
+const azureManagedIdentityMinArtifactoryVersion = "7.117.1"
+
+var azureUUIDRegex = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+var _ resource.ResourceWithValidateConfig = (*AzureManagedIdentityResource)(nil)
+var _ resource.ResourceWithImportState = (*AzureManagedIdentityResource)(nil)
+
+func NewAzureManagedIdentityResource() resource.Resource {
+	return &AzureManagedIdentityResource{
+		cloudIdentityMappingResource: cloudIdentityMappingResource{
+			TypeName:           "platform_azure_managed_identity",
+			CollectionEndpoint: AzureManagedIdentitiesEndpoint,
+			ItemEndpoint:       AzureManagedIdentityEndpoint,
+			Capability:         capabilityAzureManagedIdentity,
+			Attributes: []cloudIdentityMappingAttribute{
+				{
+					Name: "tenant_id",
+					Validators: []validator.String{
+						stringvalidator.RegexMatches(azureUUIDRegex, "Must be a UUID"),
+					},
+					MarkdownDescription: "The Microsoft Entra tenant ID of the managed identity. Must be a UUID.",
+				},
+				{
+					Name: "client_id",
+					Validators: []validator.String{
+						stringvalidator.RegexMatches(azureUUIDRegex, "Must be a UUID"),
+					},
+					MarkdownDescription: "The client ID of the Azure managed identity, or of the application of the federated credential. Must be a UUID.",
+				},
+			},
+			MarkdownDescription: "Provides a resource to manage Azure managed identities for JFrog platform users. You can use the Azure managed identities and federated credentials for passwordless access to Azure Kubernetes Service (AKS) with workload identity.",
+		},
+	}
+}
+
+type AzureManagedIdentityResource struct {
+	cloudIdentityMappingResource
+}
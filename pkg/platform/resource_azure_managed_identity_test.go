@@ -0,0 +1,152 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform_test
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jfrog/terraform-provider-platform/v2/pkg/platform"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccAzureManagedIdentity_full(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("test-azure-managed-identity", "platform_azure_managed_identity")
+
+	id, _, _ := testutil.MkNames("test-user-", "artifactory_managed_user")
+	username := fmt.Sprintf("dummy_user%d", id)
+	email := username + "@test.com"
+
+	temp := `
+	resource "artifactory_managed_user" "{{ .username }}" {
+		name     = "{{ .username }}"
+		email    = "{{ .email }}"
+		password = "Passsw0rd!12"
+	}
+
+	resource "platform_azure_managed_identity" "{{ .name }}" {
+		username  = artifactory_managed_user.{{ .username }}.name
+		tenant_id = "00000000-0000-0000-0000-000000000000"
+		client_id = "{{ .client_id }}"
+	}`
+
+	testData := map[string]string{
+		"name":      name,
+		"email":     email,
+		"username":  username,
+		"client_id": "11111111-1111-1111-1111-111111111111",
+	}
+
+	config := util.ExecuteTemplate(name, temp, testData)
+
+	updatedTestData := map[string]string{
+		"name":      name,
+		"email":     email,
+		"username":  username,
+		"client_id": "22222222-2222-2222-2222-222222222222",
+	}
+
+	updatedConfig := util.ExecuteTemplate(name, temp, updatedTestData)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"artifactory": {
+				Source: "jfrog/artifactory",
+			},
+		},
+		ProtoV6ProviderFactories: testAccProviders(),
+		CheckDestroy:             testAccCloudIdentityMappingDestroy(fqrn, platform.AzureManagedIdentityEndpoint),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "username", testData["username"]),
+					resource.TestCheckResourceAttr(fqrn, "tenant_id", "00000000-0000-0000-0000-000000000000"),
+					resource.TestCheckResourceAttr(fqrn, "client_id", testData["client_id"]),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "username", updatedTestData["username"]),
+					resource.TestCheckResourceAttr(fqrn, "client_id", updatedTestData["client_id"]),
+				),
+			},
+			{
+				ResourceName:                         fqrn,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateId:                        updatedTestData["username"],
+				ImportStateVerifyIdentifierAttribute: "username",
+			},
+		},
+	})
+}
+
+func TestAccAzureManagedIdentity_invalid_client_id(t *testing.T) {
+	_, _, name := testutil.MkNames("test-azure-managed-identity", "platform_azure_managed_identity")
+
+	config := util.ExecuteTemplate(name, `
+	resource "platform_azure_managed_identity" "{{ .name }}" {
+		username  = "{{ .name }}"
+		tenant_id = "00000000-0000-0000-0000-000000000000"
+		client_id = "not-a-uuid"
+	}`, map[string]string{
+		"name": name,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Must be a UUID`),
+			},
+		},
+	})
+}
+
+// testAccCloudIdentityMappingDestroy checks that the cloud identity mapping of
+// the user of the resource id is deleted, endpoint being its item endpoint.
+func testAccCloudIdentityMappingDestroy(id, endpoint string) func(*terraform.State) error {
+	return func(s *terraform.State) error {
+		c := TestProvider.(*platform.PlatformProvider).Meta.Client
+
+		rs, ok := s.RootModule().Resources[id]
+		if !ok {
+			return fmt.Errorf("error: resource id [%s] not found", id)
+		}
+
+		resp, err := c.R().
+			SetPathParam("username", rs.Primary.Attributes["username"]).
+			Get(endpoint)
+		if err != nil {
+			return err
+		}
+
+		if resp.StatusCode() == http.StatusBadRequest || resp.StatusCode() == http.StatusNotFound {
+			return nil
+		}
+
+		return fmt.Errorf("error: cloud identity mapping for username %s still exists", rs.Primary.Attributes["username"])
+	}
+}
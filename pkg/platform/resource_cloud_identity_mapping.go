@@ -0,0 +1,268 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/jfrog/terraform-provider-shared/util"
+	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
+)
+
+// cloudIdentityMappingAttribute is a string attribute of a cloud identity
+// mapping, with the same name in the schema and in the API.
+type cloudIdentityMappingAttribute struct {
+	Name                string
+	Validators          []validator.String
+	MarkdownDescription string
+}
+
+// cloudIdentityMappingResource is the common implementation of the resources
+// mapping a JFrog Platform user to a cloud identity for passwordless access,
+// e.g. an AWS IAM role. The mappings are saved with a PUT on
+// CollectionEndpoint, and read and deleted on ItemEndpoint by user name.
+// Capability is the name of the capability gating the resource.
+type cloudIdentityMappingResource struct {
+	ProviderData        util.ProviderMetadata
+	TypeName            string
+	CollectionEndpoint  string
+	ItemEndpoint        string
+	Capability          string
+	Attributes          []cloudIdentityMappingAttribute
+	MarkdownDescription string
+}
+
+// cloudIdentityGetter is the GetAttribute method of a plan or a state.
+type cloudIdentityGetter func(context.Context, path.Path, any) diag.Diagnostics
+
+// cloudIdentitySetter is the SetAttribute method of a state.
+type cloudIdentitySetter func(context.Context, path.Path, any) diag.Diagnostics
+
+// toAPIModel returns the mapping of the plan or state as API body.
+func (r *cloudIdentityMappingResource) toAPIModel(ctx context.Context, get cloudIdentityGetter) (mapping map[string]any, ds diag.Diagnostics) {
+	mapping = map[string]any{}
+
+	for _, name := range r.attributeNames() {
+		var value string
+		ds.Append(get(ctx, path.Root(name), &value)...)
+		if ds.HasError() {
+			return
+		}
+		mapping[name] = value
+	}
+
+	return
+}
+
+// fromAPIModel sets the attributes from the API mapping. Fields which are not
+// strings are ignored.
+func (r *cloudIdentityMappingResource) fromAPIModel(ctx context.Context, mapping map[string]any, set cloudIdentitySetter) (ds diag.Diagnostics) {
+	for _, name := range r.attributeNames() {
+		value, _ := mapping[name].(string)
+		ds.Append(set(ctx, path.Root(name), value)...)
+	}
+
+	return
+}
+
+func (r *cloudIdentityMappingResource) attributeNames() []string {
+	names := []string{"username"}
+	for _, attribute := range r.Attributes {
+		names = append(names, attribute.Name)
+	}
+
+	return names
+}
+
+func (r *cloudIdentityMappingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.TypeName
+}
+
+func (r *cloudIdentityMappingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"username": schema.StringAttribute{
+			Required: true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Description: "The JFrog Platform user name.",
+		},
+	}
+
+	for _, attribute := range r.Attributes {
+		attributes[attribute.Name] = schema.StringAttribute{
+			Required:            true,
+			Validators:          attribute.Validators,
+			MarkdownDescription: attribute.MarkdownDescription,
+		}
+	}
+
+	c, _ := getCapability(r.Capability)
+
+	resp.Schema = schema.Schema{
+		Attributes: attributes,
+		MarkdownDescription: r.MarkdownDescription + "\n\n" +
+			fmt.Sprintf("->Only available for %s %s or later.", c.Product, c.MinVersion),
+	}
+}
+
+func (r *cloudIdentityMappingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+func (r *cloudIdentityMappingResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(checkCapability(r.ProviderData, r.Capability)...)
+}
+
+// save creates or updates the mapping of the plan, and saves it into the state.
+func (r *cloudIdentityMappingResource) save(ctx context.Context, plan cloudIdentityGetter, state cloudIdentitySetter) (ds diag.Diagnostics, err error) {
+	mapping, ds := r.toAPIModel(ctx, plan)
+	if ds.HasError() {
+		return
+	}
+
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
+		SetBody(mapping).
+		Put(r.CollectionEndpoint)
+	if err != nil {
+		return
+	}
+
+	if response.IsError() {
+		err = fmt.Errorf("%s", response.String())
+		return
+	}
+
+	// Save data into Terraform state
+	ds.Append(r.fromAPIModel(ctx, mapping, state)...)
+
+	return
+}
+
+func (r *cloudIdentityMappingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	resp.Diagnostics.Append(checkCapability(r.ProviderData, r.Capability)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ds, err := r.save(ctx, req.Plan.GetAttribute, resp.State.SetAttribute)
+	resp.Diagnostics.Append(ds...)
+	if err != nil {
+		utilfw.UnableToCreateResourceError(resp, err.Error())
+	}
+}
+
+func (r *cloudIdentityMappingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var username string
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("username"), &username)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var mapping map[string]any
+
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
+		SetPathParam("username", username).
+		SetResult(&mapping).
+		Get(r.ItemEndpoint)
+
+	if err != nil {
+		utilfw.UnableToRefreshResourceError(resp, err.Error())
+		return
+	}
+
+	// Treat HTTP 404 Not Found status as a signal to recreate resource
+	// and return early
+	if response.StatusCode() == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if response.IsError() {
+		utilfw.UnableToRefreshResourceError(resp, response.String())
+		return
+	}
+
+	// Convert from the API data model to the Terraform data model
+	// and refresh any attribute values.
+	resp.Diagnostics.Append(r.fromAPIModel(ctx, mapping, resp.State.SetAttribute)...)
+}
+
+func (r *cloudIdentityMappingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	resp.Diagnostics.Append(checkCapability(r.ProviderData, r.Capability)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ds, err := r.save(ctx, req.Plan.GetAttribute, resp.State.SetAttribute)
+	resp.Diagnostics.Append(ds...)
+	if err != nil {
+		utilfw.UnableToUpdateResourceError(resp, err.Error())
+	}
+}
+
+func (r *cloudIdentityMappingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var username string
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("username"), &username)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
+		SetPathParam("username", username).
+		Delete(r.ItemEndpoint)
+
+	if err != nil {
+		utilfw.UnableToDeleteResourceError(resp, err.Error())
+		return
+	}
+
+	if response.IsError() {
+		utilfw.UnableToDeleteResourceError(resp, response.String())
+		return
+	}
+
+	// If the logic reaches here, it implicitly succeeded and will remove
+	// the resource from state if there are no other errors.
+}
+
+func (r *cloudIdentityMappingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("username"), req, resp)
+}
@@ -16,6 +16,7 @@ package platform
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
@@ -30,6 +31,7 @@ import (
 	"github.com/jfrog/terraform-provider-shared/util"
 	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
 	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+	"github.com/samber/lo"
 )
 
 func NewCrowdSettingsResource() resource.Resource {
@@ -42,6 +44,22 @@ func NewCrowdSettingsResource() resource.Resource {
 	}
 }
 
+const CrowdTestConnectionEndpoint = "access/api/v1/crowd/test"
+
+// crowdSettingsDefaults is the settings document restored on destroy when
+// restore_defaults_on_destroy is set.
+var crowdSettingsDefaults = CrowdSettingsAPIModel{
+	Enable:                     false,
+	SessionValidationInterval:  0,
+	UseDefaultProxy:            lo.ToPtr(false),
+	AutoUserCreation:           lo.ToPtr(true),
+	AllowUserToAccessProfile:   lo.ToPtr(false),
+	DirectAuthentication:       lo.ToPtr(false),
+	OverrideAllGroupsUponLogin: lo.ToPtr(false),
+}
+
+var _ resource.ResourceWithIdentity = (*CrowdSettingsResource)(nil)
+
 type CrowdSettingsResource struct {
 	util.JFrogResource
 }
@@ -57,6 +75,8 @@ type CrowdSettingsResourceModel struct {
 	AllowUserToAccessProfile   types.Bool   `tfsdk:"allow_user_to_access_profile"`
 	DirectAuthentication       types.Bool   `tfsdk:"direct_authentication"`
 	OverrideAllGroupsUponLogin types.Bool   `tfsdk:"override_all_groups_upon_login"`
+	VerifyConnection           types.Bool   `tfsdk:"verify_connection"`
+	RestoreDefaultsOnDestroy   types.Bool   `tfsdk:"restore_defaults_on_destroy"`
 }
 
 func (r *CrowdSettingsResourceModel) toAPIModel(_ context.Context, apiModel *CrowdSettingsAPIModel) diag.Diagnostics {
@@ -87,6 +107,16 @@ func (r *CrowdSettingsResourceModel) fromAPIModel(_ context.Context, apiModel *C
 	r.DirectAuthentication = types.BoolPointerValue(apiModel.DirectAuthentication)
 	r.OverrideAllGroupsUponLogin = types.BoolPointerValue(apiModel.OverrideAllGroupsUponLogin)
 
+	// verify_connection and restore_defaults_on_destroy are not stored on the server,
+	// only set their default after import
+	if r.VerifyConnection.IsNull() {
+		r.VerifyConnection = types.BoolValue(false)
+	}
+
+	if r.RestoreDefaultsOnDestroy.IsNull() {
+		r.RestoreDefaultsOnDestroy = types.BoolValue(false)
+	}
+
 	return
 }
 
@@ -170,6 +200,13 @@ func (r *CrowdSettingsResource) Schema(ctx context.Context, req resource.SchemaR
 				Default:             booldefault.StaticBool(false),
 				MarkdownDescription: "When a user logs in with CROWD, only groups retrieved from CROWD will be associated with the user. Default value is `false`.",
 			},
+			"verify_connection": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "When set, the connection to the Crowd/JIRA server is tested with `server_url`, `application_name` and `password` before the settings are applied. The apply fails with the error returned by the server when the server is unreachable or the authentication fails, and the previous settings are left in place. Default value is `false`.",
+			},
+			"restore_defaults_on_destroy": restoreDefaultsOnDestroyAttribute("`enable` set to `false`"),
 		},
 		MarkdownDescription: "Provides a JFrog [Crowd Settings](https://jfrog.com/help/r/jfrog-platform-administration-documentation/atlassian-crowd-and-jira-integration) resource. This allows you to delegate authentication requests to Atlassian Crowd/JIRA, use authenticated Crowd/JIRA users and have the JPD participate in a transparent SSO environment managed by Crowd/JIRA.",
 	}
@@ -192,8 +229,19 @@ func (r *CrowdSettingsResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	if plan.VerifyConnection.ValueBool() {
+		if err := r.verifyConnection(ctx, crowdSettings); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("verify_connection"),
+				"Unable to Connect to Crowd Server",
+				fmt.Sprintf("The connection to %s could not be verified, the settings have not been applied: %s", crowdSettings.ServerURL, err),
+			)
+			return
+		}
+	}
+
 	var jfrogErrors util.JFrogErrors
-	response, err := r.ProviderData.Client.R().
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
 		SetBody(crowdSettings).
 		SetError(&jfrogErrors).
 		Put(r.DocumentEndpoint)
@@ -210,6 +258,7 @@ func (r *CrowdSettingsResource) Create(ctx context.Context, req resource.CreateR
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setSingletonIdentity(ctx, resp.Identity)...)
 }
 
 func (r *CrowdSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -224,7 +273,7 @@ func (r *CrowdSettingsResource) Read(ctx context.Context, req resource.ReadReque
 
 	var crowdSettings CrowdSettingsAPIModel
 
-	response, err := r.ProviderData.Client.R().
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
 		SetResult(&crowdSettings).
 		Get(r.DocumentEndpoint)
 
@@ -253,6 +302,7 @@ func (r *CrowdSettingsResource) Read(ctx context.Context, req resource.ReadReque
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setSingletonIdentity(ctx, resp.Identity)...)
 }
 
 func (r *CrowdSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -272,7 +322,18 @@ func (r *CrowdSettingsResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	response, err := r.ProviderData.Client.R().
+	if plan.VerifyConnection.ValueBool() {
+		if err := r.verifyConnection(ctx, crowdSettings); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("verify_connection"),
+				"Unable to Connect to Crowd Server",
+				fmt.Sprintf("The connection to %s could not be verified, the settings have not been applied: %s", crowdSettings.ServerURL, err),
+			)
+			return
+		}
+	}
+
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
 		SetBody(crowdSettings).
 		Put(r.DocumentEndpoint)
 
@@ -288,17 +349,72 @@ func (r *CrowdSettingsResource) Update(ctx context.Context, req resource.UpdateR
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setSingletonIdentity(ctx, resp.Identity)...)
 }
 
 func (r *CrowdSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
 
-	resp.Diagnostics.AddWarning(
-		"Unable to Delete Resource",
-		"Crowd settings cannot be deleted.",
-	)
+	var state CrowdSettingsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.RestoreDefaultsOnDestroy.ValueBool() {
+		resp.Diagnostics.AddWarning(
+			"Unable to Delete Resource",
+			"Crowd settings cannot be deleted. Set restore_defaults_on_destroy to true to disable the Crowd integration on destroy.",
+		)
+		return
+	}
+
+	var jfrogErrors util.JFrogErrors
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
+		SetBody(crowdSettingsDefaults).
+		SetError(&jfrogErrors).
+		Put(r.DocumentEndpoint)
+
+	if err != nil {
+		utilfw.UnableToDeleteResourceError(resp, err.Error())
+		return
+	}
+
+	if response.IsError() {
+		utilfw.UnableToDeleteResourceError(resp, jfrogErrors.String())
+		return
+	}
+}
+
+// verifyConnection tests the connection to the Crowd/JIRA server with the
+// given settings, without saving them.
+func (r *CrowdSettingsResource) verifyConnection(ctx context.Context, crowdSettings CrowdSettingsAPIModel) error {
+	var jfrogErrors util.JFrogErrors
+	// Testing the connection changes nothing, so the POST is safe to retry
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
+		SetBody(crowdSettings).
+		SetError(&jfrogErrors).
+		AddRetryCondition(retryTransientErrors).
+		Post(CrowdTestConnectionEndpoint)
+	if err != nil {
+		return err
+	}
+
+	if response.IsError() {
+		if len(jfrogErrors.Errors) > 0 {
+			return fmt.Errorf("%s", jfrogErrors.String())
+		}
+		return fmt.Errorf("%s", response.String())
+	}
+
+	return nil
 }
 
 func (r *CrowdSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("server_url"), req, resp)
+	importSingletonState(ctx, path.Root("server_url"), req, resp)
+}
+
+func (r *CrowdSettingsResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = singletonIdentitySchema
 }
@@ -16,9 +16,12 @@ package platform_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jfrog/terraform-provider-platform/v2/pkg/platform"
 	"github.com/jfrog/terraform-provider-shared/testutil"
 	"github.com/jfrog/terraform-provider-shared/util"
 )
@@ -74,6 +77,7 @@ func TestAccCrowdSettings_full(t *testing.T) {
 					resource.TestCheckResourceAttr(fqrn, "allow_user_to_access_profile", "false"),
 					resource.TestCheckResourceAttr(fqrn, "direct_authentication", "true"),
 					resource.TestCheckResourceAttr(fqrn, "override_all_groups_upon_login", "false"),
+					resource.TestCheckResourceAttr(fqrn, "verify_connection", "false"),
 				),
 			},
 			{
@@ -98,6 +102,92 @@ func TestAccCrowdSettings_full(t *testing.T) {
 				ImportStateVerifyIdentifierAttribute: "server_url",
 				ImportStateVerifyIgnore:              []string{"password"},
 			},
+			{
+				ResourceName:                         fqrn,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateId:                        "default",
+				ImportStateVerifyIdentifierAttribute: "server_url",
+				ImportStateVerifyIgnore:              []string{"password"},
+			},
+		},
+	})
+}
+
+func TestAccCrowdSettings_verify_connection_failure(t *testing.T) {
+	_, _, name := testutil.MkNames("test-crowd-settings", "platform_crowd_settings")
+
+	temp := `
+	resource "platform_crowd_settings" "{{ .name }}" {
+		enable                      = true
+		server_url                  = "http://tempurl.org"
+		application_name            = "{{ .name }}"
+		password                    = "Password1!"
+		session_validation_interval = 1
+		verify_connection           = true
+	}`
+
+	config := util.ExecuteTemplate(name, temp, map[string]string{
+		"name": name,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`.*Unable to Connect to Crowd Server.*`),
+			},
+		},
+	})
+}
+
+func TestAccCrowdSettings_restore_defaults_on_destroy(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("test-crowd-settings", "platform_crowd_settings")
+
+	temp := `
+	resource "platform_crowd_settings" "{{ .name }}" {
+		enable                      = true
+		server_url                  = "http://tempurl.org"
+		application_name            = "{{ .name }}"
+		password                    = "Password1!"
+		session_validation_interval = 1
+		restore_defaults_on_destroy = true
+	}`
+
+	config := util.ExecuteTemplate(name, temp, map[string]string{
+		"name": name,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(),
+		CheckDestroy: func(s *terraform.State) error {
+			c := TestProvider.(*platform.PlatformProvider).Meta.Client
+
+			var crowdSettings platform.CrowdSettingsAPIModel
+			_, err := c.R().
+				SetResult(&crowdSettings).
+				Get("access/api/v1/crowd")
+			if err != nil {
+				return err
+			}
+
+			if crowdSettings.Enable {
+				return fmt.Errorf("error: Crowd settings are still enabled")
+			}
+
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "enable", "true"),
+					resource.TestCheckResourceAttr(fqrn, "restore_defaults_on_destroy", "true"),
+				),
+			},
 		},
 	})
 }
@@ -0,0 +1,60 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+const (
+	GCPServiceAccountsEndpoint = "access/api/v1/gcp/service_account"
+	GCPServiceAccountEndpoint  = "access/api/v1/gcp/service_account/{username}"
+)
+
+const gcpServiceAccountMinArtifactoryVersion = "7.117.1"
+
+var gcpServiceAccountRegex = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*@[a-z0-9.-]+\.gserviceaccount\.com$`)
+
+var _ resource.ResourceWithValidateConfig = (*GCPServiceAccountResource)(nil)
+var _ resource.ResourceWithImportState = (*GCPServiceAccountResource)(nil)
+
+func NewGCPServiceAccountResource() resource.Resource {
+	return &GCPServiceAccountResource{
+		cloudIdentityMappingResource: cloudIdentityMappingResource{
+			TypeName:           "platform_gcp_service_account",
+			CollectionEndpoint: GCPServiceAccountsEndpoint,
+			ItemEndpoint:       GCPServiceAccountEndpoint,
+			Capability:         capabilityGCPServiceAccount,
+			Attributes: []cloudIdentityMappingAttribute{
+				{
+					Name: "service_account",
+					Validators: []validator.String{
+						stringvalidator.RegexMatches(gcpServiceAccountRegex, "Must be a Google Cloud service account email, e.g. \"name@project.iam.gserviceaccount.com\""),
+					},
+					MarkdownDescription: "The email of the Google Cloud service account, e.g. `name@project.iam.gserviceaccount.com`.",
+				},
+			},
+			MarkdownDescription: "Provides a resource to manage Google Cloud service accounts for JFrog platform users. You can use the service accounts for passwordless access to Google Kubernetes Engine (GKE) with Workload Identity Federation.",
+		},
+	}
+}
+
+type GCPServiceAccountResource struct {
+	cloudIdentityMappingResource
+}
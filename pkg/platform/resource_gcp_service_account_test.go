@@ -0,0 +1,97 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jfrog/terraform-provider-platform/v2/pkg/platform"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccGCPServiceAccount_full(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("test-gcp-service-account", "platform_gcp_service_account")
+
+	id, _, _ := testutil.MkNames("test-user-", "artifactory_managed_user")
+	username := fmt.Sprintf("dummy_user%d", id)
+	email := username + "@test.com"
+
+	temp := `
+	resource "artifactory_managed_user" "{{ .username }}" {
+		name     = "{{ .username }}"
+		email    = "{{ .email }}"
+		password = "Passsw0rd!12"
+	}
+
+	resource "platform_gcp_service_account" "{{ .name }}" {
+		username        = artifactory_managed_user.{{ .username }}.name
+		service_account = "{{ .service_account }}"
+	}`
+
+	testData := map[string]string{
+		"name":            name,
+		"email":           email,
+		"username":        username,
+		"service_account": "example@my-project.iam.gserviceaccount.com",
+	}
+
+	config := util.ExecuteTemplate(name, temp, testData)
+
+	updatedTestData := map[string]string{
+		"name":            name,
+		"email":           email,
+		"username":        username,
+		"service_account": "example-2@my-project.iam.gserviceaccount.com",
+	}
+
+	updatedConfig := util.ExecuteTemplate(name, temp, updatedTestData)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"artifactory": {
+				Source: "jfrog/artifactory",
+			},
+		},
+		ProtoV6ProviderFactories: testAccProviders(),
+		CheckDestroy:             testAccCloudIdentityMappingDestroy(fqrn, platform.GCPServiceAccountEndpoint),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "username", testData["username"]),
+					resource.TestCheckResourceAttr(fqrn, "service_account", testData["service_account"]),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "username", updatedTestData["username"]),
+					resource.TestCheckResourceAttr(fqrn, "service_account", updatedTestData["service_account"]),
+				),
+			},
+			{
+				ResourceName:                         fqrn,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateId:                        updatedTestData["username"],
+				ImportStateVerifyIdentifierAttribute: "username",
+			},
+		},
+	})
+}
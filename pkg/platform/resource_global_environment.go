@@ -0,0 +1,230 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
+	"github.com/samber/lo"
+)
+
+const (
+	globalEnvironmentsEndpoint      = "/access/api/v1/environments"
+	globalEnvironmentEndpoint       = "/access/api/v1/environments/{name}"
+	globalEnvironmentRenameEndpoint = "/access/api/v1/environments/{name}/rename"
+)
+
+type globalEnvironmentAPIModel struct {
+	Name string `json:"name"`
+}
+
+type globalEnvironmentRenameAPIModel struct {
+	NewName string `json:"new_name"`
+}
+
+func listGlobalEnvironments(ctx context.Context, client *resty.Client) ([]string, error) {
+	var environments []globalEnvironmentAPIModel
+
+	response, err := client.R().SetContext(ctx).
+		SetResult(&environments).
+		Get(globalEnvironmentsEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.IsError() {
+		return nil, fmt.Errorf("%s", response.String())
+	}
+
+	return lo.Map(environments, func(environment globalEnvironmentAPIModel, _ int) string {
+		return environment.Name
+	}), nil
+}
+
+var _ resource.Resource = (*globalEnvironmentResource)(nil)
+
+type globalEnvironmentResource struct {
+	ProviderData util.ProviderMetadata
+	TypeName     string
+}
+
+func NewGlobalEnvironmentResource() resource.Resource {
+	return &globalEnvironmentResource{
+		TypeName: "platform_global_environment",
+	}
+}
+
+func (r *globalEnvironmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.TypeName
+}
+
+func (r *globalEnvironmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "Name of the environment. Changing the name renames the environment, the roles and repositories using it keep referencing it.",
+			},
+		},
+		MarkdownDescription: "Provides a JFrog [global environment](https://jfrog.com/help/r/jfrog-platform-administration-documentation/environments) resource to manage custom global environments, in addition to the predefined `DEV` and `PROD` environments.",
+	}
+}
+
+type globalEnvironmentResourceModel struct {
+	Name types.String `tfsdk:"name"`
+}
+
+func (r *globalEnvironmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+func (r *globalEnvironmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var plan globalEnvironmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	environment := globalEnvironmentAPIModel{
+		Name: plan.Name.ValueString(),
+	}
+
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
+		SetBody(&environment).
+		Post(globalEnvironmentsEndpoint)
+	if err != nil {
+		utilfw.UnableToCreateResourceError(resp, err.Error())
+		return
+	}
+
+	if response.IsError() {
+		utilfw.UnableToCreateResourceError(resp, response.String())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *globalEnvironmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var state globalEnvironmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	environments, err := listGlobalEnvironments(ctx, r.ProviderData.Client)
+	if err != nil {
+		utilfw.UnableToRefreshResourceError(resp, err.Error())
+		return
+	}
+
+	// Treat a missing environment as a signal to recreate resource
+	// and return early
+	if !lo.Contains(environments, state.Name.ValueString()) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *globalEnvironmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var plan globalEnvironmentResourceModel
+	var state globalEnvironmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rename := globalEnvironmentRenameAPIModel{
+		NewName: plan.Name.ValueString(),
+	}
+
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
+		SetPathParam("name", state.Name.ValueString()).
+		SetBody(&rename).
+		Post(globalEnvironmentRenameEndpoint)
+	if err != nil {
+		utilfw.UnableToUpdateResourceError(resp, err.Error())
+		return
+	}
+
+	if response.IsError() {
+		utilfw.UnableToUpdateResourceError(resp, response.String())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *globalEnvironmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var state globalEnvironmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
+		SetPathParam("name", state.Name.ValueString()).
+		Delete(globalEnvironmentEndpoint)
+	if err != nil {
+		utilfw.UnableToDeleteResourceError(resp, err.Error())
+		return
+	}
+
+	// Return error if the HTTP status code is not 204 No Content or 404 Not Found
+	if response.IsError() && response.StatusCode() != http.StatusNotFound {
+		utilfw.UnableToDeleteResourceError(resp, response.String())
+		return
+	}
+
+	// If the logic reaches here, it implicitly succeeded and will remove
+	// the resource from state if there are no other errors.
+}
+
+func (r *globalEnvironmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
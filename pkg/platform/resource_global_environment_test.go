@@ -0,0 +1,114 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jfrog/terraform-provider-platform/v2/pkg/platform"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccGlobalEnvironment_full(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("test-global-env", "platform_global_environment")
+	roleFqrn := fmt.Sprintf("platform_global_role.%s", name)
+
+	temp := `
+	resource "platform_global_environment" "{{ .name }}" {
+		name = "{{ .envName }}"
+	}
+
+	resource "platform_global_role" "{{ .name }}" {
+		name         = "{{ .name }}"
+		type         = "CUSTOM_GLOBAL"
+		environments = [platform_global_environment.{{ .name }}.name]
+		actions      = ["READ_REPOSITORY"]
+	}`
+
+	config := util.ExecuteTemplate(name, temp, map[string]string{
+		"name":    name,
+		"envName": name,
+	})
+
+	renamedConfig := util.ExecuteTemplate(name, temp, map[string]string{
+		"name":    name,
+		"envName": fmt.Sprintf("%s-renamed", name),
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(),
+		CheckDestroy:             testAccGlobalEnvironmentDestroy(name, fmt.Sprintf("%s-renamed", name)),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "name", name),
+					resource.TestCheckTypeSetElemAttr(roleFqrn, "environments.*", name),
+				),
+			},
+			{
+				Config: renamedConfig,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(fqrn, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "name", fmt.Sprintf("%s-renamed", name)),
+					resource.TestCheckTypeSetElemAttr(roleFqrn, "environments.*", fmt.Sprintf("%s-renamed", name)),
+				),
+			},
+			{
+				ResourceName:                         fqrn,
+				ImportState:                          true,
+				ImportStateId:                        fmt.Sprintf("%s-renamed", name),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+		},
+	})
+}
+
+func testAccGlobalEnvironmentDestroy(names ...string) func(*terraform.State) error {
+	return func(s *terraform.State) error {
+		c := TestProvider.(*platform.PlatformProvider).Meta.Client
+
+		var environments []struct {
+			Name string `json:"name"`
+		}
+		_, err := c.R().
+			SetResult(&environments).
+			Get("/access/api/v1/environments")
+		if err != nil {
+			return err
+		}
+
+		for _, environment := range environments {
+			for _, name := range names {
+				if environment.Name == name {
+					return fmt.Errorf("error: global environment %s still exists", name)
+				}
+			}
+		}
+
+		return nil
+	}
+}
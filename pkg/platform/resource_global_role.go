@@ -19,7 +19,9 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"sync"
 
+	"github.com/go-resty/resty/v2"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -33,6 +35,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/jfrog/terraform-provider-shared/util"
 	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
+	"github.com/samber/lo"
 )
 
 const (
@@ -41,6 +44,13 @@ const (
 )
 
 var globalRoleTypes []string = []string{"ADMIN", "CUSTOM_GLOBAL", "PREDEFINED"}
+
+// readOnlyGlobalRoleTypes are the types of the system roles, which can only be
+// adopted with an import and are never updated nor deleted by the provider.
+var readOnlyGlobalRoleTypes = []string{"ADMIN", "PREDEFINED"}
+
+// globalRoleActions are the actions known to the provider, allowed when the
+// action catalog cannot be read from the instance.
 var globalRoleActions []string = []string{
 	"READ_REPOSITORY",
 	"ANNOTATE_REPOSITORY",
@@ -69,7 +79,85 @@ var globalRoleActions []string = []string{
 	"READ_POLICIES_SECURITY",
 }
 
+// globalRoleActionsEndpoint is the Access roles metadata, with the actions
+// supported by the instance.
+const globalRoleActionsEndpoint = "/access/api/v1/roles/metadata"
+
+type globalRoleActionsAPIModel struct {
+	Actions []string `json:"actions"`
+}
+
+// globalRoleActionsCacheEntry is the action catalog read from an instance.
+// Requests the instance rejects, e.g. an older Access version without the
+// roles metadata, are cached along with the fallback actions, while network
+// errors and server errors are retried by the next read.
+type globalRoleActionsCacheEntry struct {
+	mu      sync.Mutex
+	cached  bool
+	actions []string
+	err     error
+
+	warnOnce sync.Once
+}
+
+// globalRoleActionsCache caches the action catalog per client, i.e. per
+// provider configuration.
+var globalRoleActionsCache sync.Map
+
+func getGlobalRoleActionsCacheEntry(client *resty.Client) *globalRoleActionsCacheEntry {
+	value, _ := globalRoleActionsCache.LoadOrStore(client, &globalRoleActionsCacheEntry{})
+	return value.(*globalRoleActionsCacheEntry)
+}
+
+// getGlobalRoleActions returns the actions supported by the instance. When
+// they cannot be read, e.g. from an older Access version, the error is
+// returned along with globalRoleActions as fallback.
+func getGlobalRoleActions(ctx context.Context, client *resty.Client) ([]string, error) {
+	return getGlobalRoleActionsCacheEntry(client).get(ctx, client)
+}
+
+func (e *globalRoleActionsCacheEntry) get(ctx context.Context, client *resty.Client) ([]string, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.cached {
+		return e.actions, e.err
+	}
+
+	var metadata globalRoleActionsAPIModel
+
+	response, err := client.R().SetContext(ctx).
+		SetResult(&metadata).
+		Get(globalRoleActionsEndpoint)
+	if err != nil {
+		return globalRoleActions, err
+	}
+
+	switch {
+	case response.StatusCode() >= http.StatusInternalServerError:
+		return globalRoleActions, fmt.Errorf("%s", response.String())
+	case response.IsError():
+		e.actions, e.err = globalRoleActions, fmt.Errorf("%s", response.String())
+	case len(metadata.Actions) == 0:
+		e.actions, e.err = globalRoleActions, fmt.Errorf("no actions returned by %s", globalRoleActionsEndpoint)
+	default:
+		e.actions, e.err = metadata.Actions, nil
+	}
+	e.cached = true
+
+	return e.actions, e.err
+}
+
+// firstWarning reports whether the fallback to the actions known to the
+// provider is not reported yet, so it is reported once per client rather
+// than once per role.
+func (e *globalRoleActionsCacheEntry) firstWarning() (first bool) {
+	e.warnOnce.Do(func() { first = true })
+	return
+}
+
 var _ resource.Resource = (*globalRoleResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*globalRoleResource)(nil)
 
 type globalRoleResource struct {
 	ProviderData util.ProviderMetadata
@@ -108,7 +196,7 @@ func (r *globalRoleResource) Schema(ctx context.Context, req resource.SchemaRequ
 				Validators: []validator.String{
 					stringvalidator.OneOf(globalRoleTypes...),
 				},
-				Description: fmt.Sprintf("Type of the role. Allowed values: %s", strings.Join(globalRoleTypes, ", ")),
+				MarkdownDescription: fmt.Sprintf("Type of the role. Allowed values: %s. Roles of type %s are system roles: they can only be imported, are left untouched by the provider, and cannot be destroyed. The type of an existing role cannot be changed.", strings.Join(globalRoleTypes, ", "), strings.Join(readOnlyGlobalRoleTypes, " and ")),
 			},
 			"environments": schema.SetAttribute{
 				ElementType: types.StringType,
@@ -119,19 +207,18 @@ func (r *globalRoleResource) Schema(ctx context.Context, req resource.SchemaRequ
 				PlanModifiers: []planmodifier.Set{
 					setplanmodifier.UseStateForUnknown(),
 				},
-				Description: "List of global or custom environments. A repository can be available in different environments. Members with roles defined in the set environment will have access to the repository.",
+				Description: "List of global or custom environments. A repository can be available in different environments. Members with roles defined in the set environment will have access to the repository. Each environment must exist, or be created by a `platform_global_environment` resource of the configuration before the role, e.g. by referencing its `name`. Plan warns about environments which do not exist, and apply fails if they still do not exist when the role is created or updated.",
 			},
 			"actions": schema.SetAttribute{
 				ElementType: types.StringType,
 				Required:    true,
 				Validators: []validator.Set{
 					setvalidator.SizeAtLeast(1),
-					setvalidator.ValueStringsAre(stringvalidator.OneOf(globalRoleActions...)),
 				},
 				PlanModifiers: []planmodifier.Set{
 					setplanmodifier.UseStateForUnknown(),
 				},
-				Description: fmt.Sprintf("List of actions. Allowed values: %s", strings.Join(globalRoleActions, ", ")),
+				MarkdownDescription: "List of actions. The allowed values are read from the connected instance, see the `platform_role_actions` data source. Without access to them, the values known to the provider are allowed: " + strings.Join(globalRoleActions, ", "),
 			},
 		},
 		MarkdownDescription: "Provides a JFrog [global role](https://jfrog.com/help/r/jfrog-platform-administration-documentation/global-and-project-role-types) resource to manage custom global roles.",
@@ -212,6 +299,200 @@ func (r *globalRoleResource) Configure(ctx context.Context, req resource.Configu
 	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
 }
 
+// ModifyPlan checks the type, environments and actions against the state and
+// the connected instance instead of leaving it to the server.
+func (r *globalRoleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan globalRoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state *globalRoleResourceModel
+	if !req.State.Raw.IsNull() {
+		state = &globalRoleResourceModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(validateGlobalRoleType(ctx, &plan, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.validateEnvironments(ctx, &plan, false)...)
+	resp.Diagnostics.Append(r.validateActions(ctx, &plan)...)
+}
+
+// validateGlobalRoleType checks that a system role is neither created nor
+// modified, and that the type of an existing role is unchanged. state is nil
+// when the role is planned for creation.
+func validateGlobalRoleType(ctx context.Context, plan, state *globalRoleResourceModel) (ds diag.Diagnostics) {
+	if plan.Type.IsUnknown() {
+		return
+	}
+
+	if state == nil {
+		if lo.Contains(readOnlyGlobalRoleTypes, plan.Type.ValueString()) {
+			ds.AddAttributeError(
+				path.Root("type"),
+				"Unable to Create System Role",
+				fmt.Sprintf("Roles of type %s are system roles and cannot be created. "+
+					"Import the existing role '%s' to manage it with Terraform, or use the CUSTOM_GLOBAL type.",
+					plan.Type.ValueString(), plan.Name.ValueString()),
+			)
+		}
+		return
+	}
+
+	if !plan.Type.Equal(state.Type) {
+		ds.AddAttributeError(
+			path.Root("type"),
+			"Role Type Cannot Be Changed",
+			fmt.Sprintf("The type of role '%s' cannot be changed from %s to %s. "+
+				"Create a new role with the desired type instead.",
+				state.Name.ValueString(), state.Type.ValueString(), plan.Type.ValueString()),
+		)
+		return
+	}
+
+	if !lo.Contains(readOnlyGlobalRoleTypes, state.Type.ValueString()) {
+		return
+	}
+
+	changes := []struct {
+		attribute string
+		changed   bool
+	}{
+		{"description", !plan.Description.IsUnknown() && !plan.Description.Equal(state.Description)},
+		{"environments", !plan.Environments.IsUnknown() && !plan.Environments.Equal(state.Environments)},
+		{"actions", !plan.Actions.IsUnknown() && !plan.Actions.Equal(state.Actions)},
+	}
+
+	for _, change := range changes {
+		if change.changed {
+			ds.AddAttributeError(
+				path.Root(change.attribute),
+				"Unable to Update System Role",
+				fmt.Sprintf("Role '%s' of type %s is a system role and is read-only. "+
+					"Set %s to the value of the imported role.",
+					state.Name.ValueString(), state.Type.ValueString(), change.attribute),
+			)
+		}
+	}
+
+	return
+}
+
+// validateEnvironments checks that the known environments exist. When planning,
+// missing environments are reported as a warning, as they may be created by a
+// platform_global_environment resource of the configuration before the role.
+// When applying, such environments have been created, so missing environments
+// are reported as an error before the role is sent to the server.
+func (r *globalRoleResource) validateEnvironments(ctx context.Context, plan *globalRoleResourceModel, applying bool) (ds diag.Diagnostics) {
+	if plan.Environments.IsUnknown() || plan.Environments.IsNull() {
+		return
+	}
+
+	var environments []types.String
+	ds.Append(plan.Environments.ElementsAs(ctx, &environments, false)...)
+	if ds.HasError() {
+		return
+	}
+
+	// Unknown environments, e.g. the name of an environment which is not
+	// planned yet, are validated when applying
+	knownEnvironments := lo.FilterMap(environments, func(environment types.String, _ int) (string, bool) {
+		return environment.ValueString(), !environment.IsUnknown()
+	})
+	if len(knownEnvironments) == 0 {
+		return
+	}
+
+	existingEnvironments, err := listGlobalEnvironments(ctx, r.ProviderData.Client)
+	if err != nil {
+		ds.AddAttributeWarning(
+			path.Root("environments"),
+			"Unable to Validate Environments",
+			fmt.Sprintf("Failed to list the global environments, the environments are not validated: %s", err.Error()),
+		)
+		return
+	}
+
+	for _, environment := range knownEnvironments {
+		if lo.Contains(existingEnvironments, environment) {
+			continue
+		}
+
+		if applying {
+			ds.AddAttributeError(
+				path.Root("environments"),
+				"Environment Not Found",
+				fmt.Sprintf("Global environment '%s' does not exist. Existing environments: %s. "+
+					"Create it with a platform_global_environment resource and reference its name, or fix the environment name.",
+					environment, strings.Join(existingEnvironments, ", ")),
+			)
+			continue
+		}
+
+		ds.AddAttributeWarning(
+			path.Root("environments"),
+			"Environment Not Found",
+			fmt.Sprintf("Global environment '%s' does not exist. Existing environments: %s. "+
+				"Apply fails unless the environment is created first, e.g. by a platform_global_environment resource whose name is referenced.",
+				environment, strings.Join(existingEnvironments, ", ")),
+		)
+	}
+
+	return
+}
+
+// validateActions checks the actions against the action catalog of the
+// connected instance, see getGlobalRoleActions.
+func (r *globalRoleResource) validateActions(ctx context.Context, plan *globalRoleResourceModel) (ds diag.Diagnostics) {
+	if plan.Actions.IsUnknown() || plan.Actions.IsNull() {
+		return
+	}
+
+	var actions []string
+	ds.Append(plan.Actions.ElementsAs(ctx, &actions, true)...)
+	if ds.HasError() {
+		return
+	}
+
+	entry := getGlobalRoleActionsCacheEntry(r.ProviderData.Client)
+	allowedActions, err := entry.get(ctx, r.ProviderData.Client)
+	if err != nil && entry.firstWarning() {
+		ds.AddAttributeWarning(
+			path.Root("actions"),
+			"Unable to Read Role Actions",
+			fmt.Sprintf("The actions of all the roles are validated against the actions known to the provider instead. Error: %s", err.Error()),
+		)
+	}
+
+	for _, action := range actions {
+		// unknown elements are converted to empty strings
+		if action == "" || lo.Contains(allowedActions, action) {
+			continue
+		}
+
+		ds.AddAttributeError(
+			path.Root("actions"),
+			"Invalid Action",
+			fmt.Sprintf("Action '%s' is not supported by this instance. Allowed values: %s", action, strings.Join(allowedActions, ", ")),
+		)
+	}
+
+	return
+}
+
 func (r *globalRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
 
@@ -222,13 +503,18 @@ func (r *globalRoleResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	resp.Diagnostics.Append(r.validateEnvironments(ctx, &plan, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var role globalRoleAPIModel
 	resp.Diagnostics.Append(plan.toAPIModel(ctx, &role)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	response, err := r.ProviderData.Client.R().
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
 		SetBody(&role).
 		Post(globalRolePostEndpoint)
 	if err != nil {
@@ -256,7 +542,7 @@ func (r *globalRoleResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	var role globalRoleAPIModel
 
-	response, err := r.ProviderData.Client.R().
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
 		SetPathParam("name", state.Name.ValueString()).
 		SetResult(&role).
 		Get(globalRoleGetEndpoint)
@@ -298,13 +584,18 @@ func (r *globalRoleResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	resp.Diagnostics.Append(r.validateEnvironments(ctx, &plan, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var role globalRoleAPIModel
 	resp.Diagnostics.Append(plan.toAPIModel(ctx, &role)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	response, err := r.ProviderData.Client.R().
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
 		SetPathParam("name", plan.Name.ValueString()).
 		SetBody(&role).
 		Put(globalRoleGetEndpoint)
@@ -332,7 +623,17 @@ func (r *globalRoleResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
-	response, err := r.ProviderData.Client.R().
+	if lo.Contains(readOnlyGlobalRoleTypes, state.Type.ValueString()) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete System Role",
+			fmt.Sprintf("Role '%s' of type %s is a system role and cannot be deleted. "+
+				"Use a removed block with destroy = false, or terraform state rm, to stop managing it with Terraform.",
+				state.Name.ValueString(), state.Type.ValueString()),
+		)
+		return
+	}
+
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
 		SetPathParam("name", state.Name.ValueString()).
 		Delete(globalRoleGetEndpoint)
 	if err != nil {
@@ -0,0 +1,96 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync/atomic"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+)
+
+func TestGetGlobalRoleActions_caching(t *testing.T) {
+	testCases := map[string]struct {
+		status           int
+		body             string
+		expectedRequests int32
+		expectError      bool
+		expectFallback   bool
+	}{
+		"success is cached": {
+			status:           http.StatusOK,
+			body:             `{"actions": ["READ_REPOSITORY"]}`,
+			expectedRequests: 1,
+		},
+		"client error is cached": {
+			status:           http.StatusNotFound,
+			body:             `{"errors": [{"code": "NOT_FOUND"}]}`,
+			expectedRequests: 1,
+			expectError:      true,
+			expectFallback:   true,
+		},
+		"server error is retried": {
+			status:           http.StatusInternalServerError,
+			body:             `{"errors": [{"code": "INTERNAL_ERROR"}]}`,
+			expectedRequests: 3,
+			expectError:      true,
+			expectFallback:   true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var requests atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				requests.Add(1)
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(testCase.status)
+				w.Write([]byte(testCase.body))
+			}))
+			defer server.Close()
+
+			client := resty.New().SetBaseURL(server.URL)
+
+			for range 3 {
+				actions, err := getGlobalRoleActions(context.Background(), client)
+				if (err != nil) != testCase.expectError {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if testCase.expectFallback != slices.Equal(actions, globalRoleActions) {
+					t.Fatalf("unexpected actions: %v", actions)
+				}
+			}
+
+			if got := requests.Load(); got != testCase.expectedRequests {
+				t.Errorf("expected %d requests, got %d", testCase.expectedRequests, got)
+			}
+		})
+	}
+}
+
+func TestGlobalRoleActionsCacheEntry_firstWarning(t *testing.T) {
+	entry := getGlobalRoleActionsCacheEntry(resty.New())
+
+	if !entry.firstWarning() {
+		t.Error("expected the first warning to be reported")
+	}
+	if entry.firstWarning() {
+		t.Error("expected the warning to be reported only once")
+	}
+}
@@ -15,10 +15,13 @@
 package platform_test
 
 import (
+	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/jfrog/terraform-provider-shared/testutil"
 	"github.com/jfrog/terraform-provider-shared/util"
 )
@@ -160,3 +163,156 @@ func TestAccGlobalRole_name_change(t *testing.T) {
 		},
 	})
 }
+
+func TestAccGlobalRole_unknown_environment(t *testing.T) {
+	_, _, roleName := testutil.MkNames("test-global-role", "platform_global_role")
+
+	temp := `
+	resource "platform_global_role" "{{ .name }}" {
+		name         = "{{ .name }}"
+		type         = "CUSTOM_GLOBAL"
+		environments = ["DEV", "PRDO"]
+		actions      = ["READ_REPOSITORY"]
+	}`
+
+	config := util.ExecuteTemplate(roleName, temp, map[string]string{
+		"name": roleName,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Global environment 'PRDO' does not exist`),
+			},
+		},
+	})
+}
+
+func TestAccGlobalRole_invalid_action(t *testing.T) {
+	_, _, roleName := testutil.MkNames("test-global-role", "platform_global_role")
+
+	temp := `
+	resource "platform_global_role" "{{ .name }}" {
+		name         = "{{ .name }}"
+		type         = "CUSTOM_GLOBAL"
+		environments = ["DEV"]
+		actions      = ["READ_REPOSITORY", "READ_REPOSITROY"]
+	}`
+
+	config := util.ExecuteTemplate(roleName, temp, map[string]string{
+		"name": roleName,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Action 'READ_REPOSITROY' is not supported`),
+			},
+		},
+	})
+}
+
+func TestAccGlobalRole_type_change(t *testing.T) {
+	_, _, roleName := testutil.MkNames("test-global-role", "platform_global_role")
+
+	temp := `
+	resource "platform_global_role" "{{ .name }}" {
+		name         = "{{ .name }}"
+		type         = "{{ .type }}"
+		environments = ["DEV"]
+		actions      = ["READ_REPOSITORY"]
+	}`
+
+	config := util.ExecuteTemplate(roleName, temp, map[string]string{
+		"name": roleName,
+		"type": "CUSTOM_GLOBAL",
+	})
+
+	updatedConfig := util.ExecuteTemplate(roleName, temp, map[string]string{
+		"name": roleName,
+		"type": "ADMIN",
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				Config:      updatedConfig,
+				ExpectError: regexp.MustCompile(`Role Type Cannot Be Changed`),
+			},
+		},
+	})
+}
+
+func TestAccGlobalRole_create_system_role(t *testing.T) {
+	_, _, roleName := testutil.MkNames("test-global-role", "platform_global_role")
+
+	temp := `
+	resource "platform_global_role" "{{ .name }}" {
+		name         = "{{ .name }}"
+		type         = "PREDEFINED"
+		environments = ["DEV"]
+		actions      = ["READ_REPOSITORY"]
+	}`
+
+	config := util.ExecuteTemplate(roleName, temp, map[string]string{
+		"name": roleName,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Unable to Create System Role`),
+			},
+		},
+	})
+}
+
+func TestAccGlobalRole_import_predefined(t *testing.T) {
+	fqrn := "platform_global_role.developer"
+
+	config := `
+	resource "platform_global_role" "developer" {
+		name         = "Developer"
+		type         = "PREDEFINED"
+		environments = ["DEV"]
+		actions      = ["READ_REPOSITORY"]
+	}`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config:        config,
+				ResourceName:  fqrn,
+				ImportState:   true,
+				ImportStateId: "Developer",
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 imported role, got %d", len(states))
+					}
+
+					if roleType := states[0].Attributes["type"]; roleType != "PREDEFINED" {
+						return fmt.Errorf("expected type PREDEFINED, got %s", roleType)
+					}
+
+					return nil
+				},
+			},
+		},
+	})
+}
@@ -16,7 +16,6 @@ package platform
 
 import (
 	"context"
-	"fmt"
 	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -241,33 +240,35 @@ func (r *groupResource) ValidateConfig(ctx context.Context, req resource.Validat
 	}
 
 	// Rule 2: role booleans require Artifactory >= groupRolesArtifactoryVersion.
-	if r.ProviderData == nil || r.ProviderData.ArtifactoryVersion == "" {
-		return
-	}
-	ok, err := util.CheckVersion(r.ProviderData.ArtifactoryVersion, groupRolesArtifactoryVersion)
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to verify Artifactory version", err.Error())
+	resp.Diagnostics.Append(r.validateRoleAttributes(&data)...)
+}
+
+// validateRoleAttributes checks the role booleans which are set against the
+// group_roles capability.
+func (r *groupResource) validateRoleAttributes(data *groupResourceModelV1) (ds diag.Diagnostics) {
+	if r.ProviderData == nil {
 		return
 	}
-	if ok {
-		return
+
+	roleAttributes := []struct {
+		attr  string
+		field types.Bool
+	}{
+		{"reports_manager", data.ReportsManager},
+		{"watch_manager", data.WatchManager},
+		{"policy_manager", data.PolicyManager},
+		{"policy_viewer", data.PolicyViewer},
+		{"manage_resources", data.ManageResources},
+		{"manage_webhook", data.ManageWebhook},
 	}
 
-	addIfSet := func(field types.Bool, attr string) {
-		if !field.IsNull() && !field.IsUnknown() {
-			resp.Diagnostics.AddAttributeError(
-				path.Root(attr),
-				"Incompatible Artifactory version",
-				fmt.Sprintf("Attribute %q requires Artifactory %s or later. Detected version: %s.", attr, groupRolesArtifactoryVersion, r.ProviderData.ArtifactoryVersion),
-			)
+	for _, role := range roleAttributes {
+		if !role.field.IsNull() && !role.field.IsUnknown() {
+			ds.Append(checkAttributeCapability(*r.ProviderData, capabilityGroupRoles, path.Root(role.attr))...)
 		}
 	}
-	addIfSet(data.ReportsManager, "reports_manager")
-	addIfSet(data.WatchManager, "watch_manager")
-	addIfSet(data.PolicyManager, "policy_manager")
-	addIfSet(data.PolicyViewer, "policy_viewer")
-	addIfSet(data.ManageResources, "manage_resources")
-	addIfSet(data.ManageWebhook, "manage_webhook")
+
+	return
 }
 
 type groupResourceModelV0 struct {
@@ -418,6 +419,11 @@ func (r *groupResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	resp.Diagnostics.Append(r.validateRoleAttributes(plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var group groupAPIModel
 	resp.Diagnostics.Append(plan.toAPIModel(ctx, &group)...)
 	if resp.Diagnostics.HasError() {
@@ -426,7 +432,7 @@ func (r *groupResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	var newGroup groupAPIModel
 	var apiErrs util.JFrogErrors
-	response, err := r.ProviderData.Client.R().
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
 		SetBody(group).
 		SetResult(&newGroup).
 		SetError(&apiErrs).
@@ -474,7 +480,7 @@ func (r *groupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	var group groupAPIModel
 	var apiErrs util.JFrogErrors
-	response, err := r.ProviderData.Client.R().
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
 		SetPathParam("name", state.Name.ValueString()).
 		SetResult(&group).
 		SetError(&apiErrs).
@@ -519,6 +525,11 @@ func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	resp.Diagnostics.Append(r.validateRoleAttributes(&plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -532,7 +543,7 @@ func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	var updatedGroup groupAPIModel
 	var apiErrs util.JFrogErrors
-	response, err := r.ProviderData.Client.R().
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
 		SetPathParam("name", plan.Name.ValueString()).
 		SetBody(group).
 		SetResult(&updatedGroup).
@@ -573,7 +584,7 @@ func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	if len(memebersToAdd) > 0 || len(membersToRemove) > 0 {
 		var membersRes groupMembersResponseAPIModel
-		response, err = r.ProviderData.Client.R().
+		response, err = r.ProviderData.Client.R().SetContext(ctx).
 			SetPathParam("name", plan.Name.ValueString()).
 			SetBody(membersReq).
 			SetResult(&membersRes).
@@ -613,7 +624,7 @@ func (r *groupResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	var apiErrs util.JFrogErrors
-	response, err := r.ProviderData.Client.R().
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
 		SetPathParam("name", state.Name.ValueString()).
 		SetError(&apiErrs).
 		Delete(r.JFrogResource.DocumentEndpoint)
@@ -99,7 +99,7 @@ func (r *groupMembersResource) Create(ctx context.Context, req resource.CreateRe
 
 	var updatedGroupMembers groupMembersResponseAPIModel
 	var apiErrs util.JFrogErrors
-	response, err := r.ProviderData.Client.R().
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
 		SetPathParam("name", plan.Name.ValueString()).
 		SetBody(groupMembers).
 		SetResult(&updatedGroupMembers).
@@ -132,7 +132,7 @@ func (r *groupMembersResource) Read(ctx context.Context, req resource.ReadReques
 
 	var group groupAPIModel
 	var apiErrs util.JFrogErrors
-	response, err := r.ProviderData.Client.R().
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
 		SetPathParam("name", state.Name.ValueString()).
 		SetResult(&group).
 		SetError(&apiErrs).
@@ -208,7 +208,7 @@ func (r *groupMembersResource) Update(ctx context.Context, req resource.UpdateRe
 
 	var updatedGroupMembers groupMembersResponseAPIModel
 	var apiErrs util.JFrogErrors
-	response, err := r.ProviderData.Client.R().
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
 		SetPathParam("name", plan.Name.ValueString()).
 		SetBody(groupMembers).
 		SetResult(&updatedGroupMembers).
@@ -249,7 +249,7 @@ func (r *groupMembersResource) Delete(ctx context.Context, req resource.DeleteRe
 
 	var updatedGroupMembers groupMembersResponseAPIModel
 	var apiErrs util.JFrogErrors
-	response, err := r.ProviderData.Client.R().
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
 		SetPathParam("name", state.Name.ValueString()).
 		SetBody(groupMembers).
 		SetResult(&updatedGroupMembers).
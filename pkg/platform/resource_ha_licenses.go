@@ -0,0 +1,545 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
+	"github.com/samber/lo"
+)
+
+// haLicenseHashesPrivateKey is the private state key of the mapping from the
+// digest of each declared license key to the license hash Artifactory assigned.
+const haLicenseHashesPrivateKey = "license_hashes"
+
+var _ resource.Resource = (*haLicensesResource)(nil)
+
+type haLicensesResource struct {
+	ProviderData util.ProviderMetadata
+	TypeName     string
+}
+
+func NewHALicensesResource() resource.Resource {
+	return &haLicensesResource{
+		TypeName: "platform_ha_licenses",
+	}
+}
+
+func (r *haLicensesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.TypeName
+}
+
+func (r *haLicensesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"keys": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Sensitive:   true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				Description: "License keys to install in the license bucket of the cluster, usually one per node. Licenses installed by this resource that are no longer declared are removed from the cluster.",
+			},
+			"licenses": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"license_hash": schema.StringAttribute{
+							Computed:    true,
+							Description: "Hash Artifactory identifies the license with.",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Type of the license.",
+						},
+						"valid_through": schema.StringAttribute{
+							Computed:    true,
+							Description: "Date of the license is valid through.",
+						},
+						"licensed_to": schema.StringAttribute{
+							Computed:    true,
+							Description: "Customer name the license belongs to.",
+						},
+						"node_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the cluster node the license is bound to. Empty when the license is not in use by any node.",
+						},
+						"node_url": schema.StringAttribute{
+							Computed:    true,
+							Description: "URL of the cluster node the license is bound to.",
+						},
+						"expired": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the license has expired.",
+						},
+					},
+				},
+				Computed:    true,
+				Description: "Licenses installed by this resource, with the cluster node each of them is bound to.",
+			},
+		},
+		MarkdownDescription: "Provides a JFrog [HA licenses](https://jfrog.com/help/r/jfrog-platform-administration-documentation/managing-licenses) resource to manage the license bucket of a high availability cluster, where each node needs its own license.\n\n" +
+			"Licenses that are installed on the cluster but were not added by this resource are left untouched.\n\n" +
+			"~>Only available for self-hosted instances. Use `platform_license` for single node instances.",
+	}
+}
+
+type haLicensesResourceModel struct {
+	Keys     types.Set  `tfsdk:"keys"`
+	Licenses types.List `tfsdk:"licenses"`
+}
+
+type haLicenseResourceModel struct {
+	LicenseHash  types.String `tfsdk:"license_hash"`
+	Type         types.String `tfsdk:"type"`
+	ValidThrough types.String `tfsdk:"valid_through"`
+	LicensedTo   types.String `tfsdk:"licensed_to"`
+	NodeID       types.String `tfsdk:"node_id"`
+	NodeURL      types.String `tfsdk:"node_url"`
+	Expired      types.Bool   `tfsdk:"expired"`
+}
+
+var haLicenseResourceModelAttributeTypes = map[string]attr.Type{
+	"license_hash":  types.StringType,
+	"type":          types.StringType,
+	"valid_through": types.StringType,
+	"licensed_to":   types.StringType,
+	"node_id":       types.StringType,
+	"node_url":      types.StringType,
+	"expired":       types.BoolType,
+}
+
+// fromAPIModel sets licenses to the installed licenses among licenseHashes, and
+// drops the keys whose license is no longer installed so they are planned again.
+func (r *haLicensesResourceModel) fromAPIModel(ctx context.Context, apiModels []haLicenseAPIModel, licenseHashes map[string]string) (ds diag.Diagnostics) {
+	installed := lo.SliceToMap(apiModels, func(license haLicenseAPIModel) (string, haLicenseAPIModel) {
+		return license.LicenseHash, license
+	})
+
+	var keys []string
+	ds.Append(r.Keys.ElementsAs(ctx, &keys, false)...)
+	if ds.HasError() {
+		return
+	}
+
+	keys = lo.Filter(keys, func(key string, _ int) bool {
+		licenseHash, ok := licenseHashes[licenseKeyDigest(key)]
+		if !ok {
+			// not mapped to a license hash, see installHALicense
+			return true
+		}
+
+		_, found := installed[licenseHash]
+		return found
+	})
+
+	keysSet, d := types.SetValueFrom(ctx, types.StringType, keys)
+	if d.HasError() {
+		ds.Append(d...)
+		return
+	}
+	r.Keys = keysSet
+
+	managedHashes := lo.Values(licenseHashes)
+	licenses := lo.FilterMap(apiModels, func(license haLicenseAPIModel, _ int) (haLicenseResourceModel, bool) {
+		return haLicenseResourceModel{
+			LicenseHash:  types.StringValue(license.LicenseHash),
+			Type:         types.StringValue(license.Type),
+			ValidThrough: types.StringValue(license.ValidThrough),
+			LicensedTo:   types.StringValue(license.LicensedTo),
+			NodeID:       types.StringValue(license.NodeID),
+			NodeURL:      types.StringValue(license.NodeURL),
+			Expired:      types.BoolValue(license.Expired),
+		}, lo.Contains(managedHashes, license.LicenseHash)
+	})
+
+	licensesList, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: haLicenseResourceModelAttributeTypes}, licenses)
+	if d.HasError() {
+		ds.Append(d...)
+		return
+	}
+	r.Licenses = licensesList
+
+	return
+}
+
+type haLicensesAPIModel struct {
+	Licenses []haLicenseAPIModel `json:"licenses"`
+}
+
+type haLicenseAPIModel struct {
+	Type         string `json:"type"`
+	ValidThrough string `json:"validThrough"`
+	LicensedTo   string `json:"licensedTo"`
+	LicenseHash  string `json:"licenseHash"`
+	NodeID       string `json:"nodeId"`
+	NodeURL      string `json:"nodeUrl"`
+	Expired      bool   `json:"expired"`
+}
+
+// licenseKeyDigest identifies a license key in the private state without
+// storing the key itself. Keys are normalized as when they are installed, so
+// keys differing only by whitespace and line endings have the same digest.
+func licenseKeyDigest(key string) string {
+	sum := sha256.Sum256([]byte(normalizeLicenseKey(key)))
+	return hex.EncodeToString(sum[:])
+}
+
+func listHALicenses(ctx context.Context, client *resty.Client) ([]haLicenseAPIModel, error) {
+	var licenses haLicensesAPIModel
+
+	response, err := client.R().SetContext(ctx).
+		SetResult(&licenses).
+		Get(licensePostEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.IsError() {
+		return nil, fmt.Errorf("%s", response.String())
+	}
+
+	return licenses.Licenses, nil
+}
+
+// installHALicense adds the license to the license bucket and returns the hash
+// of the new license, found by comparing the bucket before and after. The hash
+// is empty when the license was already installed.
+func installHALicense(ctx context.Context, client *resty.Client, key string) (string, error) {
+	before, err := listHALicenses(ctx, client)
+	if err != nil {
+		return "", err
+	}
+
+	licenses := []licenseAPIPostRequestModel{
+		{Key: key},
+	}
+
+	var errorResult licenseAPIPostResonseModel
+
+	// Installing the same licenses again is a no-op, so the POST is safe to retry
+	response, err := client.R().SetContext(ctx).
+		SetBody(&licenses).
+		SetError(&errorResult).
+		AddRetryCondition(retryTransientErrors).
+		Post(licensePostEndpoint)
+	if err != nil {
+		return "", err
+	}
+
+	if response.IsError() && !(response.StatusCode() == http.StatusBadRequest &&
+		errorResult.Messages[key] == "License already exists.") {
+		messages := lo.Values[string, string](errorResult.Messages)
+		return "", fmt.Errorf("%s", strings.Join(messages, ","))
+	}
+
+	after, err := listHALicenses(ctx, client)
+	if err != nil {
+		return "", err
+	}
+
+	beforeHashes := lo.Map(before, func(license haLicenseAPIModel, _ int) string { return license.LicenseHash })
+	newHashes := lo.Without(
+		lo.Map(after, func(license haLicenseAPIModel, _ int) string { return license.LicenseHash }),
+		beforeHashes...,
+	)
+	if len(newHashes) == 0 {
+		return "", nil
+	}
+
+	return newHashes[0], nil
+}
+
+func deleteHALicenses(ctx context.Context, client *resty.Client, licenseHashes []string) error {
+	if len(licenseHashes) == 0 {
+		return nil
+	}
+
+	response, err := client.R().SetContext(ctx).
+		SetQueryParam("licenseHash", strings.Join(licenseHashes, ",")).
+		Delete(licensePostEndpoint)
+	if err != nil {
+		return err
+	}
+
+	// Return error if the HTTP status code is not 200 OK or 404 Not Found
+	if response.IsError() && response.StatusCode() != http.StatusNotFound {
+		return fmt.Errorf("%s", response.String())
+	}
+
+	return nil
+}
+
+type privateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+func getHALicenseHashes(ctx context.Context, private privateState) (map[string]string, diag.Diagnostics) {
+	licenseHashes := map[string]string{}
+
+	data, ds := private.GetKey(ctx, haLicenseHashesPrivateKey)
+	if ds.HasError() || len(data) == 0 {
+		return licenseHashes, ds
+	}
+
+	if err := json.Unmarshal(data, &licenseHashes); err != nil {
+		ds.AddError(
+			"Unable to Read Private State",
+			"Failed to decode the license hashes: "+err.Error(),
+		)
+	}
+
+	return licenseHashes, ds
+}
+
+func encodeHALicenseHashes(licenseHashes map[string]string) []byte {
+	// a map of strings always encodes
+	data, _ := json.Marshal(licenseHashes)
+	return data
+}
+
+// installHALicenses installs the keys and records their license hash in
+// licenseHashes, returning the keys installed before any error. A warning is
+// added for keys already installed by other means, as their license cannot be
+// identified and is then left in place on removal.
+func (r *haLicensesResource) installHALicenses(ctx context.Context, keys []string, licenseHashes map[string]string) (installed []string, ds diag.Diagnostics) {
+	for _, key := range keys {
+		licenseHash, err := installHALicense(ctx, r.ProviderData.Client, normalizeLicenseKey(key))
+		if err != nil {
+			ds.AddError(
+				"Unable to Install License",
+				err.Error(),
+			)
+			return
+		}
+
+		installed = append(installed, key)
+
+		if licenseHash == "" {
+			ds.AddAttributeWarning(
+				path.Root("keys"),
+				"License Already Installed",
+				"One of the license keys was already installed on the cluster. It is kept in the license bucket when it is removed from `keys` or when the resource is destroyed.",
+			)
+			continue
+		}
+
+		licenseHashes[licenseKeyDigest(key)] = licenseHash
+	}
+
+	return
+}
+
+func (r *haLicensesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+func (r *haLicensesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var plan haLicensesResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var keys []string
+	resp.Diagnostics.Append(plan.Keys.ElementsAs(ctx, &keys, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	licenseHashes := map[string]string{}
+	_, ds := r.installHALicenses(ctx, keys, licenseHashes)
+	resp.Diagnostics.Append(ds...)
+	if resp.Diagnostics.HasError() {
+		// remove the licenses installed so far, they are not tracked in state
+		if err := deleteHALicenses(ctx, r.ProviderData.Client, lo.Values(licenseHashes)); err != nil {
+			resp.Diagnostics.AddWarning(
+				"Unable to Remove Installed Licenses",
+				err.Error(),
+			)
+		}
+		return
+	}
+
+	licenses, err := listHALicenses(ctx, r.ProviderData.Client)
+	if err != nil {
+		utilfw.UnableToCreateResourceError(resp, err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(plan.fromAPIModel(ctx, licenses, licenseHashes)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, haLicenseHashesPrivateKey, encodeHALicenseHashes(licenseHashes))...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *haLicensesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var state haLicensesResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	licenseHashes, ds := getHALicenseHashes(ctx, req.Private)
+	resp.Diagnostics.Append(ds...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	licenses, err := listHALicenses(ctx, r.ProviderData.Client)
+	if err != nil {
+		utilfw.UnableToRefreshResourceError(resp, err.Error())
+		return
+	}
+
+	// Convert from the API data model to the Terraform data model
+	// and refresh any attribute values.
+	resp.Diagnostics.Append(state.fromAPIModel(ctx, licenses, licenseHashes)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *haLicensesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var plan haLicensesResourceModel
+	var state haLicensesResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	licenseHashes, ds := getHALicenseHashes(ctx, req.Private)
+	resp.Diagnostics.Append(ds...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planKeys []string
+	var stateKeys []string
+	resp.Diagnostics.Append(plan.Keys.ElementsAs(ctx, &planKeys, false)...)
+	resp.Diagnostics.Append(state.Keys.ElementsAs(ctx, &stateKeys, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planDigests := lo.Map(planKeys, func(key string, _ int) string { return licenseKeyDigest(key) })
+	stateDigests := lo.Map(stateKeys, func(key string, _ int) string { return licenseKeyDigest(key) })
+
+	// Remove the licenses no longer declared first, so their nodes can be
+	// bound to the new licenses.
+	removedDigests, _ := lo.Difference(stateDigests, planDigests)
+	removedHashes := lo.FilterMap(removedDigests, func(digest string, _ int) (string, bool) {
+		licenseHash, ok := licenseHashes[digest]
+		return licenseHash, ok
+	})
+	if err := deleteHALicenses(ctx, r.ProviderData.Client, removedHashes); err != nil {
+		utilfw.UnableToUpdateResourceError(resp, err.Error())
+		return
+	}
+
+	for _, digest := range removedDigests {
+		delete(licenseHashes, digest)
+	}
+
+	addedKeys := lo.Filter(planKeys, func(key string, _ int) bool {
+		return !lo.Contains(stateDigests, licenseKeyDigest(key))
+	})
+	installedKeys, ds := r.installHALicenses(ctx, addedKeys, licenseHashes)
+	resp.Diagnostics.Append(ds...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, haLicenseHashesPrivateKey, encodeHALicenseHashes(licenseHashes))...)
+	if resp.Diagnostics.HasError() {
+		// keep track of the licenses removed and installed so far, so the
+		// remaining keys are planned again
+		keptKeys := lo.Filter(stateKeys, func(key string, _ int) bool {
+			return !lo.Contains(removedDigests, licenseKeyDigest(key))
+		})
+		keys, d := types.SetValueFrom(ctx, types.StringType, append(keptKeys, installedKeys...))
+		resp.Diagnostics.Append(d...)
+		if d.HasError() {
+			return
+		}
+		state.Keys = keys
+
+		if licenses, err := listHALicenses(ctx, r.ProviderData.Client); err == nil {
+			resp.Diagnostics.Append(state.fromAPIModel(ctx, licenses, licenseHashes)...)
+		}
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+
+	licenses, err := listHALicenses(ctx, r.ProviderData.Client)
+	if err != nil {
+		utilfw.UnableToUpdateResourceError(resp, err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(plan.fromAPIModel(ctx, licenses, licenseHashes)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *haLicensesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	licenseHashes, ds := getHALicenseHashes(ctx, req.Private)
+	resp.Diagnostics.Append(ds...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := deleteHALicenses(ctx, r.ProviderData.Client, lo.Values(licenseHashes)); err != nil {
+		utilfw.UnableToDeleteResourceError(resp, err.Error())
+		return
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccHALicenses_full(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("test-ha-licenses", "platform_ha_licenses")
+
+	licenseFilePath1 := os.Getenv("JFROG_HA_LICENSE_PATH_1")
+	licenseFilePath2 := os.Getenv("JFROG_HA_LICENSE_PATH_2")
+	if len(licenseFilePath1) == 0 || len(licenseFilePath2) == 0 {
+		t.Skip("env var JFROG_HA_LICENSE_PATH_1 or JFROG_HA_LICENSE_PATH_2 is not set")
+	}
+
+	temp := `
+	resource "platform_ha_licenses" "{{ .name }}" {
+		keys = [
+		{{- range .paths }}
+			file("{{ . }}"),
+		{{- end }}
+		]
+	}`
+
+	config := util.ExecuteTemplate(name, temp, map[string]any{
+		"name":  name,
+		"paths": []string{licenseFilePath1},
+	})
+
+	bothConfig := util.ExecuteTemplate(name, temp, map[string]any{
+		"name":  name,
+		"paths": []string{licenseFilePath1, licenseFilePath2},
+	})
+
+	replacedConfig := util.ExecuteTemplate(name, temp, map[string]any{
+		"name":  name,
+		"paths": []string{licenseFilePath2},
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "keys.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "licenses.#", "1"),
+					resource.TestCheckResourceAttrSet(fqrn, "licenses.0.license_hash"),
+					resource.TestCheckResourceAttrSet(fqrn, "licenses.0.type"),
+					resource.TestCheckResourceAttrSet(fqrn, "licenses.0.valid_through"),
+					resource.TestCheckResourceAttrSet(fqrn, "licenses.0.licensed_to"),
+					resource.TestCheckResourceAttr(fqrn, "licenses.0.expired", "false"),
+				),
+			},
+			{
+				Config: bothConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "keys.#", "2"),
+					resource.TestCheckResourceAttr(fqrn, "licenses.#", "2"),
+				),
+			},
+			{
+				Config: replacedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "keys.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "licenses.#", "1"),
+				),
+			},
+		},
+	})
+}
@@ -41,16 +41,29 @@ func NewHTTPSSOSettingsResource() resource.Resource {
 	}
 }
 
+var _ resource.ResourceWithIdentity = (*HTTPSSOSettingsResource)(nil)
+
 type HTTPSSOSettingsResource struct {
 	util.JFrogResource
 }
 
+// httpSSOSettingsDefaults is the settings document restored on destroy when
+// restore_defaults_on_destroy is set.
+var httpSSOSettingsDefaults = HTTPSSOSettingsAPIModel{
+	Proxied:                   false,
+	AutoCreateUser:            false,
+	AllowUserToAccessProfile:  false,
+	RemoteUserRequestVariable: "REMOTE_USER",
+	SyncLDAPGroups:            false,
+}
+
 type HTTPSSOSettingsResourceModel struct {
 	Proxied                   types.Bool   `tfsdk:"proxied"`
 	AutoCreateUser            types.Bool   `tfsdk:"auto_create_user"`
 	AllowUserToAccessProfile  types.Bool   `tfsdk:"allow_user_to_access_profile"`
 	RemoteUserRequestVariable types.String `tfsdk:"remote_user_request_variable"`
 	SyncLDAPGroups            types.Bool   `tfsdk:"sync_ldap_groups"`
+	RestoreDefaultsOnDestroy  types.Bool   `tfsdk:"restore_defaults_on_destroy"`
 }
 
 func (r *HTTPSSOSettingsResourceModel) toAPIModel(_ context.Context, apiModel *HTTPSSOSettingsAPIModel) diag.Diagnostics {
@@ -72,6 +85,11 @@ func (r *HTTPSSOSettingsResourceModel) fromAPIModel(_ context.Context, apiModel
 	r.RemoteUserRequestVariable = types.StringValue(apiModel.RemoteUserRequestVariable)
 	r.SyncLDAPGroups = types.BoolValue(apiModel.SyncLDAPGroups)
 
+	// restore_defaults_on_destroy is not stored on the server, only set its default after import
+	if r.RestoreDefaultsOnDestroy.IsNull() {
+		r.RestoreDefaultsOnDestroy = types.BoolValue(false)
+	}
+
 	return
 }
 
@@ -117,6 +135,7 @@ func (r *HTTPSSOSettingsResource) Schema(ctx context.Context, req resource.Schem
 				Default:             booldefault.StaticBool(false),
 				MarkdownDescription: "When set, the user will be associated with the groups returned in the LDAP login response. Note that the user's association with the returned groups is persistent if the `auto_create_user` is set. Default to `false`.",
 			},
+			"restore_defaults_on_destroy": restoreDefaultsOnDestroyAttribute("`proxied` set to `false`"),
 		},
 		MarkdownDescription: "Provides a JFrog [HTTP SSO Settings](https://jfrog.com/help/r/jfrog-platform-administration-documentation/http-sso) resource. This allows you to reuse existing HTTP-based SSO infrastructures with the JFrog Platform Unit (JPD), such as the SSO modules offered by Apache HTTPd.",
 	}
@@ -140,7 +159,7 @@ func (r *HTTPSSOSettingsResource) Create(ctx context.Context, req resource.Creat
 	}
 
 	var jfrogErrors util.JFrogErrors
-	response, err := r.ProviderData.Client.R().
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
 		SetBody(httpSSOSettings).
 		SetError(&jfrogErrors).
 		Put(r.DocumentEndpoint)
@@ -157,6 +176,7 @@ func (r *HTTPSSOSettingsResource) Create(ctx context.Context, req resource.Creat
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setSingletonIdentity(ctx, resp.Identity)...)
 }
 
 func (r *HTTPSSOSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -171,7 +191,7 @@ func (r *HTTPSSOSettingsResource) Read(ctx context.Context, req resource.ReadReq
 
 	var httpSSOSettings HTTPSSOSettingsAPIModel
 
-	response, err := r.ProviderData.Client.R().
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
 		SetResult(&httpSSOSettings).
 		Get(r.DocumentEndpoint)
 
@@ -200,6 +220,7 @@ func (r *HTTPSSOSettingsResource) Read(ctx context.Context, req resource.ReadReq
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setSingletonIdentity(ctx, resp.Identity)...)
 }
 
 func (r *HTTPSSOSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -219,7 +240,7 @@ func (r *HTTPSSOSettingsResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
-	response, err := r.ProviderData.Client.R().
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
 		SetBody(httpSSOSettings).
 		Put(r.DocumentEndpoint)
 
@@ -235,17 +256,46 @@ func (r *HTTPSSOSettingsResource) Update(ctx context.Context, req resource.Updat
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setSingletonIdentity(ctx, resp.Identity)...)
 }
 
 func (r *HTTPSSOSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
 
-	resp.Diagnostics.AddWarning(
-		"Unable to Delete Resource",
-		"HTTP SSO settings cannot be deleted.",
-	)
+	var state HTTPSSOSettingsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.RestoreDefaultsOnDestroy.ValueBool() {
+		resp.Diagnostics.AddWarning(
+			"Unable to Delete Resource",
+			"HTTP SSO settings cannot be deleted. Set restore_defaults_on_destroy to true to disable HTTP SSO on destroy.",
+		)
+		return
+	}
+
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
+		SetBody(httpSSOSettingsDefaults).
+		Put(r.DocumentEndpoint)
+
+	if err != nil {
+		utilfw.UnableToDeleteResourceError(resp, err.Error())
+		return
+	}
+
+	if response.IsError() {
+		utilfw.UnableToDeleteResourceError(resp, response.String())
+		return
+	}
 }
 
 func (r *HTTPSSOSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("remote_user_request_variable"), req, resp)
+	importSingletonState(ctx, path.Root("remote_user_request_variable"), req, resp)
+}
+
+func (r *HTTPSSOSettingsResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = singletonIdentitySchema
 }
@@ -19,6 +19,8 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jfrog/terraform-provider-platform/v2/pkg/platform"
 	"github.com/jfrog/terraform-provider-shared/testutil"
 	"github.com/jfrog/terraform-provider-shared/util"
 )
@@ -88,6 +90,64 @@ func TestAccHTTPSSOSettings_full(t *testing.T) {
 				ImportStateId:                        name,
 				ImportStateVerifyIdentifierAttribute: "remote_user_request_variable",
 			},
+			{
+				ResourceName:                         fqrn,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateId:                        "default",
+				ImportStateVerifyIdentifierAttribute: "remote_user_request_variable",
+			},
+			{
+				ResourceName:    fqrn,
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func TestAccHTTPSSOSettings_restore_defaults_on_destroy(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("test-http-sso-settings", "platform_http_sso_settings")
+
+	temp := `
+	resource "platform_http_sso_settings" "{{ .name }}" {
+		proxied                      = true
+		remote_user_request_variable = "TEST"
+		restore_defaults_on_destroy  = true
+	}`
+
+	config := util.ExecuteTemplate(name, temp, map[string]string{
+		"name": name,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(),
+		CheckDestroy: func(s *terraform.State) error {
+			c := TestProvider.(*platform.PlatformProvider).Meta.Client
+
+			var httpSSOSettings platform.HTTPSSOSettingsAPIModel
+			_, err := c.R().
+				SetResult(&httpSSOSettings).
+				Get("access/api/v1/httpsso")
+			if err != nil {
+				return err
+			}
+
+			if httpSSOSettings.Proxied || httpSSOSettings.RemoteUserRequestVariable != "REMOTE_USER" {
+				return fmt.Errorf("error: HTTP SSO settings were not restored to their defaults")
+			}
+
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "proxied", "true"),
+					resource.TestCheckResourceAttr(fqrn, "restore_defaults_on_destroy", "true"),
+				),
+			},
 		},
 	})
 }
@@ -0,0 +1,378 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
+)
+
+const (
+	LDAPGroupSettingsEndpoint = "access/api/v1/ldap/groups"
+	LDAPGroupSettingEndpoint  = "access/api/v1/ldap/groups/{name}"
+)
+
+var ldapGroupStrategies = []string{"STATIC", "DYNAMIC", "HIERARCHICAL"}
+
+func NewLDAPGroupSettingResource() resource.Resource {
+	return &LDAPGroupSettingResource{
+		JFrogResource: util.JFrogResource{
+			TypeName:                "platform_ldap_group_setting",
+			ValidArtifactoryVersion: "7.57.1",
+			DocumentEndpoint:        LDAPGroupSettingEndpoint,
+			CollectionEndpoint:      LDAPGroupSettingsEndpoint,
+		},
+	}
+}
+
+var _ resource.ResourceWithValidateConfig = (*LDAPGroupSettingResource)(nil)
+
+type LDAPGroupSettingResource struct {
+	util.JFrogResource
+}
+
+type LDAPGroupSettingResourceModel struct {
+	Name                 types.String `tfsdk:"name"`
+	EnabledLDAP          types.String `tfsdk:"enabled_ldap"`
+	GroupBaseDN          types.String `tfsdk:"group_base_dn"`
+	GroupNameAttribute   types.String `tfsdk:"group_name_attribute"`
+	GroupMemberAttribute types.String `tfsdk:"group_member_attribute"`
+	SubTree              types.Bool   `tfsdk:"sub_tree"`
+	ForceAttributeSearch types.Bool   `tfsdk:"force_attribute_search"`
+	Filter               types.String `tfsdk:"filter"`
+	DescriptionAttribute types.String `tfsdk:"description_attribute"`
+	Strategy             types.String `tfsdk:"strategy"`
+}
+
+func (r *LDAPGroupSettingResourceModel) toAPIModel(_ context.Context, apiModel *LDAPGroupSettingAPIModel) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	*apiModel = LDAPGroupSettingAPIModel{
+		Name:                 r.Name.ValueString(),
+		EnabledLDAP:          r.EnabledLDAP.ValueString(),
+		GroupBaseDN:          r.GroupBaseDN.ValueString(),
+		GroupNameAttribute:   r.GroupNameAttribute.ValueString(),
+		GroupMemberAttribute: r.GroupMemberAttribute.ValueString(),
+		SubTree:              r.SubTree.ValueBool(),
+		ForceAttributeSearch: r.ForceAttributeSearch.ValueBool(),
+		Filter:               r.Filter.ValueString(),
+		DescriptionAttribute: r.DescriptionAttribute.ValueString(),
+		Strategy:             r.Strategy.ValueString(),
+	}
+
+	return diags
+}
+
+func (r *LDAPGroupSettingResourceModel) fromAPIModel(_ context.Context, apiModel *LDAPGroupSettingAPIModel) (ds diag.Diagnostics) {
+	r.Name = types.StringValue(apiModel.Name)
+	r.EnabledLDAP = types.StringValue(apiModel.EnabledLDAP)
+
+	r.GroupBaseDN = types.StringNull()
+	if len(apiModel.GroupBaseDN) > 0 {
+		r.GroupBaseDN = types.StringValue(apiModel.GroupBaseDN)
+	}
+
+	r.GroupNameAttribute = types.StringValue(apiModel.GroupNameAttribute)
+	r.GroupMemberAttribute = types.StringValue(apiModel.GroupMemberAttribute)
+	r.SubTree = types.BoolValue(apiModel.SubTree)
+	r.ForceAttributeSearch = types.BoolValue(apiModel.ForceAttributeSearch)
+	r.Filter = types.StringValue(apiModel.Filter)
+	r.DescriptionAttribute = types.StringValue(apiModel.DescriptionAttribute)
+	r.Strategy = types.StringValue(apiModel.Strategy)
+
+	return
+}
+
+type LDAPGroupSettingAPIModel struct {
+	Name                 string `json:"name"`
+	EnabledLDAP          string `json:"enabled_ldap"`
+	GroupBaseDN          string `json:"group_base_dn"`
+	GroupNameAttribute   string `json:"group_name_attribute"`
+	GroupMemberAttribute string `json:"group_member_attribute"`
+	SubTree              bool   `json:"sub_tree"`
+	ForceAttributeSearch bool   `json:"force_attribute_search"`
+	Filter               string `json:"filter"`
+	DescriptionAttribute string `json:"description_attribute"`
+	Strategy             string `json:"strategy"`
+}
+
+func (r *LDAPGroupSettingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "The name of the LDAP group setting. This is the name referenced by `ldap_group_settings` in `platform_saml_settings`.",
+			},
+			"enabled_ldap": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				MarkdownDescription: "The `key` of the LDAP setting (`platform_ldap_setting`) used to look up the groups.",
+			},
+			"group_base_dn": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				MarkdownDescription: "A search base for group entry DNs, relative to the DN on the LDAP server's URL. When not set, groups are searched from the root DN of the LDAP server's URL.",
+			},
+			"group_name_attribute": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				MarkdownDescription: "Attribute on the group entry denoting the group name. Used when importing groups.",
+			},
+			"group_member_attribute": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				MarkdownDescription: "A multi-value attribute on the group entry containing user DNs or IDs of the group members (e.g., `uniqueMember`, `member`).",
+			},
+			"sub_tree": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "When set, enables deep search through the sub-tree of the LDAP URL + search base. Default value is `true`.",
+			},
+			"force_attribute_search": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Forces the search of the group membership attribute on the user entry, which is needed by some LDAP servers. Only applicable when `strategy` is `DYNAMIC`. Default value is `false`.",
+			},
+			"filter": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				MarkdownDescription: "The LDAP filter used to search for group entries. Used for importing groups.",
+			},
+			"description_attribute": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("description"),
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				MarkdownDescription: "An attribute on the group entry denoting the group description. Used when importing groups. Default value is `description`.",
+			},
+			"strategy": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(ldapGroupStrategies...),
+				},
+				MarkdownDescription: "The JFrog Platform supports three ways of mapping groups to LDAP schemas: `STATIC`: Group objects are aware of their members, however, the users are not aware of the groups they belong to. Each group object such as groupOfNames or groupOfUniqueNames holds its respective member attributes, typically member or uniqueMember, which is a user DN. `DYNAMIC`: User objects are aware of what groups they belong to, but the group objects are not aware of their members. Each user object contains a custom attribute, such as group, that holds the group DNs or group names of which the user is a member. `HIERARCHICAL`: The user's DN is indicative of the groups the user belongs to by using group names as part of user DN hierarchy. Each user DN contains a list of ou's or custom attributes that make up the group association.",
+			},
+		},
+		MarkdownDescription: "Provides a JFrog [LDAP Group Setting](https://jfrog.com/help/r/jfrog-platform-administration-documentation/ldap-groups) resource. This allows you to synchronize groups from an LDAP server configured with `platform_ldap_setting`.",
+	}
+}
+
+func (r *LDAPGroupSettingResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	r.JFrogResource.ValidateConfig(ctx, req, resp)
+
+	var config LDAPGroupSettingResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Strategy.IsUnknown() || config.ForceAttributeSearch.IsUnknown() {
+		return
+	}
+
+	if config.ForceAttributeSearch.ValueBool() && config.Strategy.ValueString() != "DYNAMIC" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("force_attribute_search"),
+			"Invalid Attribute Configuration",
+			"force_attribute_search can only be set to true when strategy is DYNAMIC.",
+		)
+	}
+}
+
+func (r *LDAPGroupSettingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var plan LDAPGroupSettingResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ldapGroupSetting LDAPGroupSettingAPIModel
+	resp.Diagnostics.Append(plan.toAPIModel(ctx, &ldapGroupSetting)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var jfrogErrors util.JFrogErrors
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
+		SetBody(ldapGroupSetting).
+		SetError(&jfrogErrors).
+		Post(r.CollectionEndpoint)
+
+	if err != nil {
+		utilfw.UnableToCreateResourceError(resp, err.Error())
+		return
+	}
+
+	if response.IsError() {
+		utilfw.UnableToCreateResourceError(resp, jfrogErrors.String())
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *LDAPGroupSettingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var state LDAPGroupSettingResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ldapGroupSetting LDAPGroupSettingAPIModel
+
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
+		SetPathParam("name", state.Name.ValueString()).
+		SetResult(&ldapGroupSetting).
+		Get(r.DocumentEndpoint)
+
+	if err != nil {
+		utilfw.UnableToRefreshResourceError(resp, err.Error())
+		return
+	}
+
+	// Treat HTTP 404 Not Found status as a signal to recreate resource
+	// and return early
+	if response.StatusCode() == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if response.IsError() {
+		utilfw.UnableToRefreshResourceError(resp, response.String())
+		return
+	}
+
+	// Convert from the API data model to the Terraform data model
+	// and refresh any attribute values.
+	resp.Diagnostics.Append(state.fromAPIModel(ctx, &ldapGroupSetting)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *LDAPGroupSettingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var plan LDAPGroupSettingResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ldapGroupSetting LDAPGroupSettingAPIModel
+	resp.Diagnostics.Append(plan.toAPIModel(ctx, &ldapGroupSetting)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var jfrogErrors util.JFrogErrors
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
+		SetPathParam("name", plan.Name.ValueString()).
+		SetBody(ldapGroupSetting).
+		SetError(&jfrogErrors).
+		Put(r.DocumentEndpoint)
+
+	if err != nil {
+		utilfw.UnableToUpdateResourceError(resp, err.Error())
+		return
+	}
+
+	if response.IsError() {
+		utilfw.UnableToUpdateResourceError(resp, jfrogErrors.String())
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *LDAPGroupSettingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var state LDAPGroupSettingResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
+		SetPathParam("name", state.Name.ValueString()).
+		Delete(r.DocumentEndpoint)
+
+	if err != nil {
+		utilfw.UnableToDeleteResourceError(resp, err.Error())
+		return
+	}
+
+	// Return error if the HTTP status code is not 204 No Content or 404 Not Found
+	if response.StatusCode() != http.StatusNotFound && response.IsError() {
+		utilfw.UnableToDeleteResourceError(resp, response.String())
+		return
+	}
+
+	// If the logic reaches here, it implicitly succeeded and will remove
+	// the resource from state if there are no other errors.
+}
+
+func (r *LDAPGroupSettingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,165 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform_test
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jfrog/terraform-provider-platform/v2/pkg/platform"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccLDAPGroupSetting_full(t *testing.T) {
+	_, _, ldapName := testutil.MkNames("test-ldap-setting", "platform_ldap_setting")
+	_, fqrn, name := testutil.MkNames("test-ldap-group-setting", "platform_ldap_group_setting")
+
+	temp := `
+	resource "platform_ldap_setting" "{{ .ldap_name }}" {
+		key             = "{{ .ldap_name }}"
+		ldap_url        = "ldap://ldap.tempurl.org/dc=tempurl,dc=org"
+		user_dn_pattern = "uid={0},ou=People"
+	}
+
+	resource "platform_ldap_group_setting" "{{ .name }}" {
+		name                   = "{{ .name }}"
+		enabled_ldap           = platform_ldap_setting.{{ .ldap_name }}.key
+		group_base_dn          = "ou=groups"
+		group_name_attribute   = "cn"
+		group_member_attribute = "{{ .group_member_attribute }}"
+		sub_tree               = true
+		filter                 = "(objectClass={{ .object_class }})"
+		description_attribute  = "description"
+		strategy               = "{{ .strategy }}"
+	}`
+
+	testData := map[string]string{
+		"ldap_name":              ldapName,
+		"name":                   name,
+		"group_member_attribute": "uniqueMember",
+		"object_class":           "groupOfNames",
+		"strategy":               "STATIC",
+	}
+
+	config := util.ExecuteTemplate(name, temp, testData)
+
+	updatedTestData := map[string]string{
+		"ldap_name":              ldapName,
+		"name":                   name,
+		"group_member_attribute": "memberOf",
+		"object_class":           "group",
+		"strategy":               "DYNAMIC",
+	}
+
+	updatedConfig := util.ExecuteTemplate(name, temp, updatedTestData)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(),
+		CheckDestroy:             testAccLDAPGroupSettingDestroy(fqrn),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "name", testData["name"]),
+					resource.TestCheckResourceAttr(fqrn, "enabled_ldap", testData["ldap_name"]),
+					resource.TestCheckResourceAttr(fqrn, "group_base_dn", "ou=groups"),
+					resource.TestCheckResourceAttr(fqrn, "group_name_attribute", "cn"),
+					resource.TestCheckResourceAttr(fqrn, "group_member_attribute", testData["group_member_attribute"]),
+					resource.TestCheckResourceAttr(fqrn, "sub_tree", "true"),
+					resource.TestCheckResourceAttr(fqrn, "force_attribute_search", "false"),
+					resource.TestCheckResourceAttr(fqrn, "filter", "(objectClass=groupOfNames)"),
+					resource.TestCheckResourceAttr(fqrn, "strategy", testData["strategy"]),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "group_member_attribute", updatedTestData["group_member_attribute"]),
+					resource.TestCheckResourceAttr(fqrn, "filter", "(objectClass=group)"),
+					resource.TestCheckResourceAttr(fqrn, "strategy", updatedTestData["strategy"]),
+				),
+			},
+			{
+				ResourceName:                         fqrn,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateId:                        name,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+		},
+	})
+}
+
+func TestAccLDAPGroupSetting_force_attribute_search_requires_dynamic(t *testing.T) {
+	_, _, name := testutil.MkNames("test-ldap-group-setting", "platform_ldap_group_setting")
+
+	temp := `
+	resource "platform_ldap_group_setting" "{{ .name }}" {
+		name                   = "{{ .name }}"
+		enabled_ldap           = "my-ldap"
+		group_name_attribute   = "cn"
+		group_member_attribute = "uniqueMember"
+		force_attribute_search = true
+		filter                 = "(objectClass=groupOfNames)"
+		strategy               = "STATIC"
+	}`
+
+	config := util.ExecuteTemplate(name, temp, map[string]string{
+		"name": name,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`.*force_attribute_search can only be set to true when strategy is DYNAMIC.*`),
+			},
+		},
+	})
+}
+
+func testAccLDAPGroupSettingDestroy(id string) func(*terraform.State) error {
+	return func(s *terraform.State) error {
+		c := TestProvider.(*platform.PlatformProvider).Meta.Client
+
+		rs, ok := s.RootModule().Resources[id]
+		if !ok {
+			return fmt.Errorf("error: resource id [%s] not found", id)
+		}
+
+		var ldapGroupSetting platform.LDAPGroupSettingAPIModel
+		resp, err := c.R().
+			SetPathParam("name", rs.Primary.Attributes["name"]).
+			SetResult(&ldapGroupSetting).
+			Get(platform.LDAPGroupSettingEndpoint)
+		if err != nil {
+			return err
+		}
+
+		if resp != nil && resp.StatusCode() == http.StatusNotFound {
+			return nil
+		}
+
+		return fmt.Errorf("error: LDAP Group Setting %s still exists", rs.Primary.Attributes["name"])
+	}
+}
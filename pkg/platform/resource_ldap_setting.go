@@ -0,0 +1,474 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
+)
+
+const (
+	LDAPSettingsEndpoint = "access/api/v1/ldap/settings"
+	LDAPSettingEndpoint  = "access/api/v1/ldap/settings/{key}"
+)
+
+var ldapURLRegex = regexp.MustCompile(`^ldaps?://.+`)
+
+func NewLDAPSettingResource() resource.Resource {
+	return &LDAPSettingResource{
+		JFrogResource: util.JFrogResource{
+			TypeName:                "platform_ldap_setting",
+			ValidArtifactoryVersion: "7.57.1",
+			DocumentEndpoint:        LDAPSettingEndpoint,
+			CollectionEndpoint:      LDAPSettingsEndpoint,
+		},
+	}
+}
+
+var _ resource.ResourceWithValidateConfig = (*LDAPSettingResource)(nil)
+
+type LDAPSettingResource struct {
+	util.JFrogResource
+}
+
+type LDAPSettingResourceModel struct {
+	Key                      types.String `tfsdk:"key"`
+	Enabled                  types.Bool   `tfsdk:"enabled"`
+	LDAPURL                  types.String `tfsdk:"ldap_url"`
+	UserDNPattern            types.String `tfsdk:"user_dn_pattern"`
+	SearchFilter             types.String `tfsdk:"search_filter"`
+	SearchBase               types.String `tfsdk:"search_base"`
+	SearchSubTree            types.Bool   `tfsdk:"search_sub_tree"`
+	ManagerDN                types.String `tfsdk:"manager_dn"`
+	ManagerPassword          types.String `tfsdk:"manager_password"`
+	ManagerPasswordWO        types.String `tfsdk:"manager_password_wo"`
+	ManagerPasswordWOVersion types.Int64  `tfsdk:"manager_password_wo_version"`
+	EmailAttribute           types.String `tfsdk:"email_attribute"`
+	AutoCreateUser           types.Bool   `tfsdk:"auto_create_user"`
+	AllowUserToAccessProfile types.Bool   `tfsdk:"allow_user_to_access_profile"`
+	PagingSupportEnabled     types.Bool   `tfsdk:"paging_support_enabled"`
+	LDAPPoisoningProtection  types.Bool   `tfsdk:"ldap_poisoning_protection"`
+}
+
+// toAPIModel converts the plan to the API model. The write-only manager
+// password is only available from the configuration, hence the separate argument.
+func (r *LDAPSettingResourceModel) toAPIModel(_ context.Context, managerPasswordWO types.String, apiModel *LDAPSettingAPIModel) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	managerPassword := r.ManagerPassword.ValueString()
+	if !managerPasswordWO.IsNull() {
+		managerPassword = managerPasswordWO.ValueString()
+	}
+
+	*apiModel = LDAPSettingAPIModel{
+		Key:                      r.Key.ValueString(),
+		Enabled:                  r.Enabled.ValueBool(),
+		LDAPURL:                  r.LDAPURL.ValueString(),
+		UserDNPattern:            r.UserDNPattern.ValueString(),
+		SearchFilter:             r.SearchFilter.ValueString(),
+		SearchBase:               r.SearchBase.ValueString(),
+		SearchSubTree:            r.SearchSubTree.ValueBool(),
+		ManagerDN:                r.ManagerDN.ValueString(),
+		ManagerPassword:          managerPassword,
+		EmailAttribute:           r.EmailAttribute.ValueString(),
+		AutoCreateUser:           r.AutoCreateUser.ValueBool(),
+		AllowUserToAccessProfile: r.AllowUserToAccessProfile.ValueBool(),
+		PagingSupportEnabled:     r.PagingSupportEnabled.ValueBool(),
+		LDAPPoisoningProtection:  r.LDAPPoisoningProtection.ValueBool(),
+	}
+
+	return diags
+}
+
+// fromAPIModel refreshes the model from the API. The manager password is
+// never returned by the server so the value in state is kept as is.
+func (r *LDAPSettingResourceModel) fromAPIModel(_ context.Context, apiModel *LDAPSettingAPIModel) (ds diag.Diagnostics) {
+	r.Key = types.StringValue(apiModel.Key)
+	r.Enabled = types.BoolValue(apiModel.Enabled)
+	r.LDAPURL = types.StringValue(apiModel.LDAPURL)
+
+	r.UserDNPattern = types.StringNull()
+	if len(apiModel.UserDNPattern) > 0 {
+		r.UserDNPattern = types.StringValue(apiModel.UserDNPattern)
+	}
+
+	r.SearchFilter = types.StringNull()
+	if len(apiModel.SearchFilter) > 0 {
+		r.SearchFilter = types.StringValue(apiModel.SearchFilter)
+	}
+
+	r.SearchBase = types.StringNull()
+	if len(apiModel.SearchBase) > 0 {
+		r.SearchBase = types.StringValue(apiModel.SearchBase)
+	}
+
+	r.ManagerDN = types.StringNull()
+	if len(apiModel.ManagerDN) > 0 {
+		r.ManagerDN = types.StringValue(apiModel.ManagerDN)
+	}
+
+	r.SearchSubTree = types.BoolValue(apiModel.SearchSubTree)
+	r.EmailAttribute = types.StringValue(apiModel.EmailAttribute)
+	r.AutoCreateUser = types.BoolValue(apiModel.AutoCreateUser)
+	r.AllowUserToAccessProfile = types.BoolValue(apiModel.AllowUserToAccessProfile)
+	r.PagingSupportEnabled = types.BoolValue(apiModel.PagingSupportEnabled)
+	r.LDAPPoisoningProtection = types.BoolValue(apiModel.LDAPPoisoningProtection)
+
+	return
+}
+
+type LDAPSettingAPIModel struct {
+	Key                      string `json:"key"`
+	Enabled                  bool   `json:"enabled"`
+	LDAPURL                  string `json:"ldap_url"`
+	UserDNPattern            string `json:"user_dn_pattern,omitempty"`
+	SearchFilter             string `json:"search_filter,omitempty"`
+	SearchBase               string `json:"search_base,omitempty"`
+	SearchSubTree            bool   `json:"search_sub_tree"`
+	ManagerDN                string `json:"manager_dn,omitempty"`
+	ManagerPassword          string `json:"manager_password,omitempty"`
+	EmailAttribute           string `json:"email_attribute"`
+	AutoCreateUser           bool   `json:"auto_create_user"`
+	AllowUserToAccessProfile bool   `json:"allow_user_to_access_profile"`
+	PagingSupportEnabled     bool   `json:"paging_support_enabled"`
+	LDAPPoisoningProtection  bool   `json:"ldap_poisoning_protection"`
+}
+
+func (r *LDAPSettingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"key": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "The unique ID of the LDAP setting.",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "When set, these settings are enabled. Default value is `true`.",
+			},
+			"ldap_url": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(ldapURLRegex, "must be a valid LDAP URL starting with 'ldap://' or 'ldaps://'"),
+				},
+				MarkdownDescription: "Location of the LDAP server in the following format: `ldap://myldapserver/dc=sampledomain,dc=com`.",
+			},
+			"user_dn_pattern": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				MarkdownDescription: "A DN pattern used to log users directly in to the LDAP database, e.g. `uid={0},ou=People`. Either `user_dn_pattern` or `search_filter` must be set.",
+			},
+			"search_filter": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				MarkdownDescription: "A filter expression used to search for the user DN used in LDAP authentication, e.g. `(uid={0})`. Either `user_dn_pattern` or `search_filter` must be set.",
+			},
+			"search_base": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				MarkdownDescription: "The context name in which to search relative to the base DN in `ldap_url`. Multiple search bases may be separated by a pipe (`|`).",
+			},
+			"search_sub_tree": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "When set, enables deep search through the sub-tree of the LDAP URL + search base. Default value is `true`.",
+			},
+			"manager_dn": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				MarkdownDescription: "The full DN of a user with permissions that allow querying the LDAP server. When working with LDAP Groups, the user should have permissions for any extra group attributes such as memberOf.",
+			},
+			"manager_password": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("manager_dn")),
+					stringvalidator.ConflictsWith(path.MatchRoot("manager_password_wo")),
+				},
+				MarkdownDescription: "The password of the user bound to `manager_dn`. The value is stored in the Terraform state, use `manager_password_wo` to avoid this.",
+			},
+			"manager_password_wo": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("manager_dn")),
+				},
+				MarkdownDescription: "Write-only variant of `manager_password`, which is never stored in the Terraform state. Requires Terraform 1.11 or later. Change `manager_password_wo_version` to update the password.",
+			},
+			"manager_password_wo_version": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("manager_password_wo")),
+				},
+				MarkdownDescription: "Version of `manager_password_wo`. As write-only values are not stored in the state, changing this value is what triggers an update of the password.",
+			},
+			"email_attribute": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("mail"),
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				MarkdownDescription: "An attribute that can be used to map a user's email to a user created automatically. Default value is `mail`.",
+			},
+			"auto_create_user": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "When set, users are automatically created when using LDAP. Otherwise, users are transient and associated with auto-join groups defined in Artifactory. Default value is `true`.",
+			},
+			"allow_user_to_access_profile": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Auto created users will have access to their profile page and will be able to perform actions such as generating an API key. Default value is `false`.",
+			},
+			"paging_support_enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "When set, supports paging results for the LDAP server. This feature requires that the LDAP Server supports a PagedResultsControl configuration. Default value is `true`.",
+			},
+			"ldap_poisoning_protection": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Protects against LDAP poisoning by filtering out users exposed to vulnerabilities. Default value is `true`.",
+			},
+		},
+		MarkdownDescription: "Provides a JFrog [LDAP Setting](https://jfrog.com/help/r/jfrog-platform-administration-documentation/ldap) resource. This allows you to authenticate users against one or more LDAP servers.",
+	}
+}
+
+func (r *LDAPSettingResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	r.JFrogResource.ValidateConfig(ctx, req, resp)
+
+	var config LDAPSettingResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.UserDNPattern.IsUnknown() || config.SearchFilter.IsUnknown() {
+		return
+	}
+
+	if config.UserDNPattern.IsNull() && config.SearchFilter.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("search_filter"),
+			"Missing Attribute Configuration",
+			"Either user_dn_pattern or search_filter must be configured.",
+		)
+	}
+}
+
+func (r *LDAPSettingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var plan LDAPSettingResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var managerPasswordWO types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("manager_password_wo"), &managerPasswordWO)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ldapSetting LDAPSettingAPIModel
+	resp.Diagnostics.Append(plan.toAPIModel(ctx, managerPasswordWO, &ldapSetting)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var jfrogErrors util.JFrogErrors
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
+		SetBody(ldapSetting).
+		SetError(&jfrogErrors).
+		Post(r.CollectionEndpoint)
+
+	if err != nil {
+		utilfw.UnableToCreateResourceError(resp, err.Error())
+		return
+	}
+
+	if response.IsError() {
+		utilfw.UnableToCreateResourceError(resp, jfrogErrors.String())
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *LDAPSettingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var state LDAPSettingResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ldapSetting LDAPSettingAPIModel
+
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
+		SetPathParam("key", state.Key.ValueString()).
+		SetResult(&ldapSetting).
+		Get(r.DocumentEndpoint)
+
+	if err != nil {
+		utilfw.UnableToRefreshResourceError(resp, err.Error())
+		return
+	}
+
+	// Treat HTTP 404 Not Found status as a signal to recreate resource
+	// and return early
+	if response.StatusCode() == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if response.IsError() {
+		utilfw.UnableToRefreshResourceError(resp, response.String())
+		return
+	}
+
+	// Convert from the API data model to the Terraform data model
+	// and refresh any attribute values.
+	resp.Diagnostics.Append(state.fromAPIModel(ctx, &ldapSetting)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *LDAPSettingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var plan LDAPSettingResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var managerPasswordWO types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("manager_password_wo"), &managerPasswordWO)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ldapSetting LDAPSettingAPIModel
+	resp.Diagnostics.Append(plan.toAPIModel(ctx, managerPasswordWO, &ldapSetting)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var jfrogErrors util.JFrogErrors
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
+		SetPathParam("key", plan.Key.ValueString()).
+		SetBody(ldapSetting).
+		SetError(&jfrogErrors).
+		Put(r.DocumentEndpoint)
+
+	if err != nil {
+		utilfw.UnableToUpdateResourceError(resp, err.Error())
+		return
+	}
+
+	if response.IsError() {
+		utilfw.UnableToUpdateResourceError(resp, jfrogErrors.String())
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *LDAPSettingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var state LDAPSettingResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
+		SetPathParam("key", state.Key.ValueString()).
+		Delete(r.DocumentEndpoint)
+
+	if err != nil {
+		utilfw.UnableToDeleteResourceError(resp, err.Error())
+		return
+	}
+
+	// Return error if the HTTP status code is not 204 No Content or 404 Not Found
+	if response.StatusCode() != http.StatusNotFound && response.IsError() {
+		utilfw.UnableToDeleteResourceError(resp, response.String())
+		return
+	}
+
+	// If the logic reaches here, it implicitly succeeded and will remove
+	// the resource from state if there are no other errors.
+}
+
+func (r *LDAPSettingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("key"), req, resp)
+}
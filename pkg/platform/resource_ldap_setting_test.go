@@ -0,0 +1,207 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform_test
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jfrog/terraform-provider-platform/v2/pkg/platform"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccLDAPSetting_full(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("test-ldap-setting", "platform_ldap_setting")
+
+	temp := `
+	resource "platform_ldap_setting" "{{ .name }}" {
+		key                          = "{{ .name }}"
+		enabled                      = true
+		ldap_url                     = "ldap://ldap.tempurl.org/dc=tempurl,dc=org"
+		user_dn_pattern              = "uid={0},ou=People"
+		search_filter                = "{{ .search_filter }}"
+		search_base                  = "ou=people"
+		search_sub_tree              = true
+		manager_dn                   = "cn=admin,dc=tempurl,dc=org"
+		manager_password             = "Password1!"
+		email_attribute              = "{{ .email_attribute }}"
+		auto_create_user             = {{ .auto_create_user }}
+		allow_user_to_access_profile = false
+		paging_support_enabled       = false
+		ldap_poisoning_protection    = true
+	}`
+
+	testData := map[string]string{
+		"name":             name,
+		"search_filter":    "(uid={0})",
+		"email_attribute":  "mail",
+		"auto_create_user": "true",
+	}
+
+	config := util.ExecuteTemplate(name, temp, testData)
+
+	updatedTestData := map[string]string{
+		"name":             name,
+		"search_filter":    "(&(objectClass=person)(uid={0}))",
+		"email_attribute":  "email",
+		"auto_create_user": "false",
+	}
+
+	updatedConfig := util.ExecuteTemplate(name, temp, updatedTestData)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(),
+		CheckDestroy:             testAccLDAPSettingDestroy(fqrn),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "key", testData["name"]),
+					resource.TestCheckResourceAttr(fqrn, "enabled", "true"),
+					resource.TestCheckResourceAttr(fqrn, "ldap_url", "ldap://ldap.tempurl.org/dc=tempurl,dc=org"),
+					resource.TestCheckResourceAttr(fqrn, "user_dn_pattern", "uid={0},ou=People"),
+					resource.TestCheckResourceAttr(fqrn, "search_filter", testData["search_filter"]),
+					resource.TestCheckResourceAttr(fqrn, "search_base", "ou=people"),
+					resource.TestCheckResourceAttr(fqrn, "manager_dn", "cn=admin,dc=tempurl,dc=org"),
+					resource.TestCheckResourceAttr(fqrn, "email_attribute", testData["email_attribute"]),
+					resource.TestCheckResourceAttr(fqrn, "auto_create_user", testData["auto_create_user"]),
+					resource.TestCheckResourceAttr(fqrn, "paging_support_enabled", "false"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "search_filter", updatedTestData["search_filter"]),
+					resource.TestCheckResourceAttr(fqrn, "email_attribute", updatedTestData["email_attribute"]),
+					resource.TestCheckResourceAttr(fqrn, "auto_create_user", updatedTestData["auto_create_user"]),
+				),
+			},
+			{
+				ResourceName:                         fqrn,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateId:                        name,
+				ImportStateVerifyIdentifierAttribute: "key",
+				ImportStateVerifyIgnore:              []string{"manager_password"},
+			},
+		},
+	})
+}
+
+func TestAccLDAPSetting_manager_password_wo(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("test-ldap-setting", "platform_ldap_setting")
+
+	temp := `
+	resource "platform_ldap_setting" "{{ .name }}" {
+		key                         = "{{ .name }}"
+		ldap_url                    = "ldaps://ldap.tempurl.org/dc=tempurl,dc=org"
+		search_filter               = "(uid={0})"
+		manager_dn                  = "cn=admin,dc=tempurl,dc=org"
+		manager_password_wo         = "{{ .password }}"
+		manager_password_wo_version = {{ .version }}
+	}`
+
+	config := util.ExecuteTemplate(name, temp, map[string]string{
+		"name":     name,
+		"password": "Password1!",
+		"version":  "1",
+	})
+
+	updatedConfig := util.ExecuteTemplate(name, temp, map[string]string{
+		"name":     name,
+		"password": "Password2!",
+		"version":  "2",
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(),
+		CheckDestroy:             testAccLDAPSettingDestroy(fqrn),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(fqrn, "manager_password"),
+					resource.TestCheckNoResourceAttr(fqrn, "manager_password_wo"),
+					resource.TestCheckResourceAttr(fqrn, "manager_password_wo_version", "1"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(fqrn, "manager_password_wo"),
+					resource.TestCheckResourceAttr(fqrn, "manager_password_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLDAPSetting_missing_search_filter_and_user_dn_pattern(t *testing.T) {
+	_, _, name := testutil.MkNames("test-ldap-setting", "platform_ldap_setting")
+
+	temp := `
+	resource "platform_ldap_setting" "{{ .name }}" {
+		key      = "{{ .name }}"
+		ldap_url = "ldap://ldap.tempurl.org/dc=tempurl,dc=org"
+	}`
+
+	config := util.ExecuteTemplate(name, temp, map[string]string{
+		"name": name,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`.*Either user_dn_pattern or search_filter must be configured.*`),
+			},
+		},
+	})
+}
+
+func testAccLDAPSettingDestroy(id string) func(*terraform.State) error {
+	return func(s *terraform.State) error {
+		c := TestProvider.(*platform.PlatformProvider).Meta.Client
+
+		rs, ok := s.RootModule().Resources[id]
+		if !ok {
+			return fmt.Errorf("error: resource id [%s] not found", id)
+		}
+
+		var ldapSetting platform.LDAPSettingAPIModel
+		resp, err := c.R().
+			SetPathParam("key", rs.Primary.Attributes["key"]).
+			SetResult(&ldapSetting).
+			Get(platform.LDAPSettingEndpoint)
+		if err != nil {
+			return err
+		}
+
+		if resp != nil && resp.StatusCode() == http.StatusNotFound {
+			return nil
+		}
+
+		return fmt.Errorf("error: LDAP Setting %s still exists", rs.Primary.Attributes["key"])
+	}
+}
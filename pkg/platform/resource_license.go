@@ -16,13 +16,18 @@ package platform
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/jfrog/terraform-provider-shared/util"
@@ -35,7 +40,34 @@ const (
 	licenseGetEndpoint  = "/artifactory/api/system/license"
 )
 
+const licenseExpiryWarningDays = 30
+
+// licenseValidThroughLayouts are the formats of validThrough, which Artifactory
+// returns as e.g. `May 15, 2026`.
+var licenseValidThroughLayouts = []string{"Jan 2, 2006", "January 2, 2006", "2006-01-02", time.RFC3339}
+
+func parseLicenseValidThrough(validThrough string) (time.Time, error) {
+	for _, layout := range licenseValidThroughLayouts {
+		if t, err := time.Parse(layout, validThrough); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("unsupported valid through date '%s'", validThrough)
+}
+
+// licenseDaysRemaining returns the number of days from now until the license
+// expires. The license is valid through the whole last day, so it is 0 on that
+// day and negative once the license has expired.
+func licenseDaysRemaining(validThrough, now time.Time) int64 {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	lastDay := time.Date(validThrough.Year(), validThrough.Month(), validThrough.Day(), 0, 0, 0, 0, time.UTC)
+
+	return int64(lastDay.Sub(today).Hours() / 24)
+}
+
 var _ resource.Resource = (*licenseResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*licenseResource)(nil)
 
 type licenseResource struct {
 	ProviderData util.ProviderMetadata
@@ -56,11 +88,32 @@ func (r *licenseResource) Schema(ctx context.Context, req resource.SchemaRequest
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"key": schema.StringAttribute{
-				Required:    true,
-				Description: "License key. Any newline characters must be represented by escape sequence `\n`",
+				Optional:  true,
+				Sensitive: true,
 				Validators: []validator.String{
 					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("key_wo")),
 				},
+				PlanModifiers: []planmodifier.String{
+					licenseKeyPlanModifier{},
+				},
+				MarkdownDescription: "License key. The content of a license file can be used as is, e.g. with `file()`, as whitespace and line endings are normalized before the key is installed. Keys differing only by whitespace and line endings are the same key, and changing one into the other plans no update. The value is stored in the Terraform state, use `key_wo` to avoid this.",
+			},
+			"key_wo": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				MarkdownDescription: "Write-only variant of `key`, which is never stored in the Terraform state. Requires Terraform 1.11 or later. Change `key_wo_version` to install a new license key.",
+			},
+			"key_wo_version": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("key_wo")),
+				},
+				MarkdownDescription: "Version of `key_wo`. As write-only values are not stored in the state, changing this value is what triggers the installation of the new license key.",
 			},
 			"name": schema.StringAttribute{
 				Optional:    true,
@@ -81,17 +134,62 @@ func (r *licenseResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Computed:    true,
 				Description: "Customer name the license belongs to.",
 			},
+			"expiry_warning_days": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				MarkdownDescription: fmt.Sprintf("Number of days before `valid_through` from which plan reports the license as expiring. An already expired license is always reported. Default value is `%d`.", licenseExpiryWarningDays),
+			},
 		},
 		MarkdownDescription: "Provides a JFrog [license](https://jfrog.com/help/r/jfrog-platform-administration-documentation/managing-licenses) resource to install/update license.\n\n~>Only available for self-hosted instances.",
 	}
 }
 
 type licenseResourceModel struct {
-	Key          types.String `tfsdk:"key"`
-	Name         types.String `tfsdk:"name"`
-	Type         types.String `tfsdk:"type"`
-	ValidThrough types.String `tfsdk:"valid_through"`
-	LicensedTo   types.String `tfsdk:"licensed_to"`
+	Key               types.String `tfsdk:"key"`
+	KeyWO             types.String `tfsdk:"key_wo"`
+	KeyWOVersion      types.Int64  `tfsdk:"key_wo_version"`
+	Name              types.String `tfsdk:"name"`
+	Type              types.String `tfsdk:"type"`
+	ValidThrough      types.String `tfsdk:"valid_through"`
+	LicensedTo        types.String `tfsdk:"licensed_to"`
+	ExpiryWarningDays types.Int64  `tfsdk:"expiry_warning_days"`
+}
+
+// expiryDiagnostics reports a license that expires within expiry_warning_days
+// of now, or has already expired.
+func (r *licenseResourceModel) expiryDiagnostics(now time.Time) (ds diag.Diagnostics) {
+	if r.ValidThrough.IsNull() || r.ValidThrough.IsUnknown() {
+		return
+	}
+
+	validThrough, err := parseLicenseValidThrough(r.ValidThrough.ValueString())
+	if err != nil {
+		return
+	}
+
+	warningDays := int64(licenseExpiryWarningDays)
+	if !r.ExpiryWarningDays.IsNull() && !r.ExpiryWarningDays.IsUnknown() {
+		warningDays = r.ExpiryWarningDays.ValueInt64()
+	}
+
+	switch daysRemaining := licenseDaysRemaining(validThrough, now); {
+	case daysRemaining < 0:
+		ds.AddAttributeWarning(
+			path.Root("key"),
+			"License Expired",
+			fmt.Sprintf("The %s license of %s expired on %s. Install a renewed license key.", r.Type.ValueString(), r.LicensedTo.ValueString(), r.ValidThrough.ValueString()),
+		)
+	case daysRemaining <= warningDays:
+		ds.AddAttributeWarning(
+			path.Root("key"),
+			"License Expiring Soon",
+			fmt.Sprintf("The %s license of %s is valid through %s, %d day(s) from now. Renew the license and update key before then.", r.Type.ValueString(), r.LicensedTo.ValueString(), r.ValidThrough.ValueString(), daysRemaining),
+		)
+	}
+
+	return
 }
 
 func (r *licenseResourceModel) fromAPIModel(_ context.Context, apiModel *licenseAPIGetModel) (ds diag.Diagnostics) {
@@ -102,6 +200,46 @@ func (r *licenseResourceModel) fromAPIModel(_ context.Context, apiModel *license
 	return
 }
 
+// licenseKey returns the normalized key to install. The write-only key is only
+// available from the configuration, hence the separate argument.
+func (r *licenseResourceModel) licenseKey(keyWO types.String) string {
+	if !keyWO.IsNull() {
+		return normalizeLicenseKey(keyWO.ValueString())
+	}
+
+	return normalizeLicenseKey(r.Key.ValueString())
+}
+
+// normalizeLicenseKey splits a license key, e.g. the content of a license
+// file, on any whitespace and joins the parts with newlines. Keys differing
+// only by whitespace and line endings are therefore the same key.
+func normalizeLicenseKey(key string) string {
+	return strings.Join(strings.Fields(key), "\n")
+}
+
+// licenseKeyPlanModifier keeps the key of the state when the configured key
+// only differs by whitespace and line endings, e.g. when an inline key is
+// replaced by the content of the license file, so no update is planned.
+type licenseKeyPlanModifier struct{}
+
+func (m licenseKeyPlanModifier) Description(_ context.Context) string {
+	return "Keeps the prior key when the configured key is the same license key."
+}
+
+func (m licenseKeyPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m licenseKeyPlanModifier) PlanModifyString(_ context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.StateValue.IsNull() || req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+
+	if normalizeLicenseKey(req.PlanValue.ValueString()) == normalizeLicenseKey(req.StateValue.ValueString()) {
+		resp.PlanValue = req.StateValue
+	}
+}
+
 type licenseAPIPostRequestModel struct {
 	Key string `json:"licenseKey"`
 }
@@ -125,6 +263,22 @@ func (r *licenseResource) Configure(ctx context.Context, req resource.ConfigureR
 	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
 }
 
+func (r *licenseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan licenseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// valid_through is unknown until a new key is installed
+	resp.Diagnostics.Append(plan.expiryDiagnostics(time.Now())...)
+}
+
 func (r *licenseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
 
@@ -135,15 +289,23 @@ func (r *licenseResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	var keyWO types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("key_wo"), &keyWO)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	license := licenseAPIPostRequestModel{
-		Key: plan.Key.ValueString(),
+		Key: plan.licenseKey(keyWO),
 	}
 
 	var errorResult licenseAPIPostResonseModel
 
-	response, err := r.ProviderData.Client.R().
+	// Installing the same license again is a no-op, so the POST is safe to retry
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
 		SetBody(&license).
 		SetError(&errorResult).
+		AddRetryCondition(retryTransientErrors).
 		Post(licensePostEndpoint)
 
 	if err != nil {
@@ -160,7 +322,7 @@ func (r *licenseResource) Create(ctx context.Context, req resource.CreateRequest
 
 	var licenseGet licenseAPIGetModel
 
-	response, err = r.ProviderData.Client.R().
+	response, err = r.ProviderData.Client.R().SetContext(ctx).
 		SetResult(&licenseGet).
 		Get(licenseGetEndpoint)
 
@@ -196,7 +358,7 @@ func (r *licenseResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 	var license licenseAPIGetModel
 
-	response, err := r.ProviderData.Client.R().
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
 		SetResult(&license).
 		Get(licenseGetEndpoint)
 
@@ -237,15 +399,23 @@ func (r *licenseResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	var keyWO types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("key_wo"), &keyWO)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	license := licenseAPIPostRequestModel{
-		Key: plan.Key.ValueString(),
+		Key: plan.licenseKey(keyWO),
 	}
 
 	var errorResult licenseAPIPostResonseModel
 
-	response, err := r.ProviderData.Client.R().
+	// Installing the same license again is a no-op, so the POST is safe to retry
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
 		SetBody(&license).
 		SetError(&errorResult).
+		AddRetryCondition(retryTransientErrors).
 		Post(licensePostEndpoint)
 
 	if err != nil {
@@ -262,7 +432,7 @@ func (r *licenseResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	var licenseGet licenseAPIGetModel
 
-	response, err = r.ProviderData.Client.R().
+	response, err = r.ProviderData.Client.R().SetContext(ctx).
 		SetResult(&licenseGet).
 		Get(licenseGetEndpoint)
 
@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/jfrog/terraform-provider-shared/testutil"
 	"github.com/jfrog/terraform-provider-shared/util"
 )
@@ -39,6 +40,7 @@ func TestAccLicense_full(t *testing.T) {
 	temp := `
 	resource "platform_license" "{{ .name }}" {
 		name = "{{ .name }}"
+		expiry_warning_days = 60
 		key = <<EOT
 {{ .key }}
 EOT
@@ -71,6 +73,13 @@ EOT
 	}
 	updatedConfig := util.ExecuteTemplate(licenseName, temp, updatedTestData)
 
+	// Same key as updatedConfig, with different whitespace and line endings
+	reformattedTestData := map[string]string{
+		"name": licenseName,
+		"key":  "  " + strings.Join(strings.Fields(licenseKey), "  \n\n  ") + "  ",
+	}
+	reformattedConfig := util.ExecuteTemplate(licenseName, temp, reformattedTestData)
+
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProviders(),
@@ -83,6 +92,7 @@ EOT
 					resource.TestCheckResourceAttrSet(fqrn, "type"),
 					resource.TestCheckResourceAttrSet(fqrn, "valid_through"),
 					resource.TestCheckResourceAttrSet(fqrn, "licensed_to"),
+					resource.TestCheckResourceAttr(fqrn, "expiry_warning_days", "60"),
 				),
 			},
 			{
@@ -93,6 +103,69 @@ EOT
 					resource.TestCheckResourceAttrSet(fqrn, "type"),
 					resource.TestCheckResourceAttrSet(fqrn, "valid_through"),
 					resource.TestCheckResourceAttrSet(fqrn, "licensed_to"),
+					resource.TestCheckResourceAttr(fqrn, "expiry_warning_days", "60"),
+				),
+			},
+			{
+				Config: reformattedConfig,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccLicense_write_only(t *testing.T) {
+	_, fqrn, licenseName := testutil.MkNames("test-license", "platform_license")
+
+	licenseFilePath1 := os.Getenv("JFROG_LICENSE_PATH_1")
+	licenseFilePath2 := os.Getenv("JFROG_LICENSE_PATH_2")
+	if len(licenseFilePath1) == 0 || len(licenseFilePath2) == 0 {
+		t.Skip("env var JFROG_LICENSE_PATH_1 or JFROG_LICENSE_PATH_2 is not set")
+	}
+
+	temp := `
+	resource "platform_license" "{{ .name }}" {
+		name           = "{{ .name }}"
+		key_wo         = file("{{ .path }}")
+		key_wo_version = {{ .version }}
+	}`
+
+	config := util.ExecuteTemplate(licenseName, temp, map[string]string{
+		"name":    licenseName,
+		"path":    licenseFilePath1,
+		"version": "1",
+	})
+
+	updatedConfig := util.ExecuteTemplate(licenseName, temp, map[string]string{
+		"name":    licenseName,
+		"path":    licenseFilePath2,
+		"version": "2",
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(fqrn, "key"),
+					resource.TestCheckNoResourceAttr(fqrn, "key_wo"),
+					resource.TestCheckResourceAttr(fqrn, "key_wo_version", "1"),
+					resource.TestCheckResourceAttrSet(fqrn, "type"),
+					resource.TestCheckResourceAttrSet(fqrn, "valid_through"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(fqrn, "key_wo"),
+					resource.TestCheckResourceAttr(fqrn, "key_wo_version", "2"),
+					resource.TestCheckResourceAttrSet(fqrn, "type"),
 				),
 			},
 		},
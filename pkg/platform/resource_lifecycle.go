@@ -66,21 +66,7 @@ func (r *lifecycleResource) Configure(ctx context.Context, req resource.Configur
 }
 
 func (r *lifecycleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	if r.ProviderData.AccessVersion == "" {
-		return
-	}
-	ok, err := util.CheckVersion(r.ProviderData.AccessVersion, minAccessVersionLifecycle)
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to verify Access version", err.Error())
-		return
-	}
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Incompatible Access version",
-			fmt.Sprintf("This resource is only supported by Access version %s or later.", minAccessVersionLifecycle),
-		)
-		return
-	}
+	resp.Diagnostics.Append(checkCapability(r.ProviderData, capabilityLifecycle)...)
 }
 
 // globalStageValidator validates that global stages (PR, COMMIT, PROD) are not included in promote_stages
@@ -348,6 +334,11 @@ type lifecycleStageAPI struct {
 func (r *lifecycleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
 
+	resp.Diagnostics.Append(checkCapability(r.ProviderData, capabilityLifecycle)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var plan lifecycleResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -361,7 +352,7 @@ func (r *lifecycleResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	var apiErrs util.JFrogErrors
-	request := r.ProviderData.Client.R().
+	request := r.ProviderData.Client.R().SetContext(ctx).
 		SetBody(lifecycle).
 		SetError(&apiErrs)
 
@@ -409,7 +400,7 @@ func (r *lifecycleResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	var lifecycle lifecycleAPIModel
 	var apiErrs util.JFrogErrors
-	request := r.ProviderData.Client.R().
+	request := r.ProviderData.Client.R().SetContext(ctx).
 		SetResult(&lifecycle).
 		SetError(&apiErrs)
 
@@ -446,7 +437,7 @@ func (r *lifecycleResource) Read(ctx context.Context, req resource.ReadRequest,
 func (r *lifecycleResource) readLifecycle(ctx context.Context, model *lifecycleResourceModel) diag.Diagnostics {
 	var lifecycle lifecycleAPIModel
 	var apiErrs util.JFrogErrors
-	request := r.ProviderData.Client.R().
+	request := r.ProviderData.Client.R().SetContext(ctx).
 		SetResult(&lifecycle).
 		SetError(&apiErrs)
 
@@ -480,6 +471,11 @@ func (r *lifecycleResource) readLifecycle(ctx context.Context, model *lifecycleR
 func (r *lifecycleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
 
+	resp.Diagnostics.Append(checkCapability(r.ProviderData, capabilityLifecycle)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var plan lifecycleResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -493,7 +489,7 @@ func (r *lifecycleResource) Update(ctx context.Context, req resource.UpdateReque
 	}
 
 	var apiErrs util.JFrogErrors
-	request := r.ProviderData.Client.R().
+	request := r.ProviderData.Client.R().SetContext(ctx).
 		SetBody(lifecycle).
 		SetError(&apiErrs)
 
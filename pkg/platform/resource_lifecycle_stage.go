@@ -63,22 +63,7 @@ func (r *lifecycleStageResource) Configure(ctx context.Context, req resource.Con
 }
 
 func (r *lifecycleStageResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	if r.ProviderData.AccessVersion == "" {
-		return
-	}
-	ok, err := util.CheckVersion(r.ProviderData.AccessVersion, minAccessVersionLifecycle)
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to verify Access version", err.Error())
-		return
-	}
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Incompatible Access version",
-			fmt.Sprintf("This resource is only supported by Access version %s or later.", minAccessVersionLifecycle),
-		)
-		return
-	}
-
+	resp.Diagnostics.Append(checkCapability(r.ProviderData, capabilityLifecycle)...)
 }
 
 // validateProjectScopedStageName checks that when project_key is set, name is prefixed with project_key (e.g. "bookverse-deploy" when project_key is "bookverse").
@@ -326,6 +311,11 @@ type lifecycleStageAPIModel struct {
 func (r *lifecycleStageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
 
+	resp.Diagnostics.Append(checkCapability(r.ProviderData, capabilityLifecycle)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var plan lifecycleStageResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -346,7 +336,7 @@ func (r *lifecycleStageResource) Create(ctx context.Context, req resource.Create
 	// Create: POST /access/api/v2/stages/ — request body has name, project_key (optional), category (optional); no query param per docs
 	var newStage lifecycleStageAPIModel
 	var apiErrs util.JFrogErrors
-	response, err := r.ProviderData.Client.R().
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
 		SetBody(&apiModel).
 		SetResult(&newStage).
 		SetError(&apiErrs).
@@ -388,7 +378,7 @@ func (r *lifecycleStageResource) Read(ctx context.Context, req resource.ReadRequ
 
 	var stage lifecycleStageAPIModel
 	var apiErrs util.JFrogErrors
-	request := r.ProviderData.Client.R().
+	request := r.ProviderData.Client.R().SetContext(ctx).
 		SetPathParam("name", state.Name.ValueString()).
 		SetResult(&stage).
 		SetError(&apiErrs)
@@ -433,6 +423,11 @@ func (r *lifecycleStageResource) Read(ctx context.Context, req resource.ReadRequ
 func (r *lifecycleStageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
 
+	resp.Diagnostics.Append(checkCapability(r.ProviderData, capabilityLifecycle)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var plan lifecycleStageResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -450,7 +445,7 @@ func (r *lifecycleStageResource) Update(ctx context.Context, req resource.Update
 	// If project_key is not provided, check if stage exists in global scope
 	var checkStage lifecycleStageAPIModel
 	var checkApiErrs util.JFrogErrors
-	checkRequest := r.ProviderData.Client.R().
+	checkRequest := r.ProviderData.Client.R().SetContext(ctx).
 		SetPathParam("name", plan.Name.ValueString()).
 		SetResult(&checkStage).
 		SetError(&checkApiErrs)
@@ -516,7 +511,7 @@ func (r *lifecycleStageResource) Update(ctx context.Context, req resource.Update
 
 	var updatedStage lifecycleStageAPIModel
 	var apiErrs util.JFrogErrors
-	request := r.ProviderData.Client.R().
+	request := r.ProviderData.Client.R().SetContext(ctx).
 		SetPathParam("name", plan.Name.ValueString()).
 		SetBody(updateStage).
 		SetResult(&updatedStage).
@@ -569,7 +564,7 @@ func (r *lifecycleStageResource) Delete(ctx context.Context, req resource.Delete
 	}
 
 	var apiErrs util.JFrogErrors
-	request := r.ProviderData.Client.R().
+	request := r.ProviderData.Client.R().SetContext(ctx).
 		SetPathParam("name", state.Name.ValueString()).
 		SetError(&apiErrs)
 
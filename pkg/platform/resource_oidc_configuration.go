@@ -183,7 +183,7 @@ func (r oidcConfigurationResource) ValidateConfig(ctx context.Context, req resou
 
 	// Access version 7.144.0 or later is required for the `issuer_url` attribute when `provider_type` is set to `GitHubEnterprise`
 	if data.ProviderType.ValueString() == githubEnterpriseType {
-		if ok, err := util.CheckVersion(r.ProviderData.AccessVersion, GithubEnterpriseAccessVersion); err == nil && ok {
+		if isCapabilitySupported(r.ProviderData, capabilityOIDCGitHubEnterprise) {
 			if data.IssuerURL.IsNull() || data.IssuerURL.ValueString() == "" {
 				resp.Diagnostics.AddAttributeError(
 					path.Root("issuer_url"),
@@ -198,7 +198,7 @@ func (r oidcConfigurationResource) ValidateConfig(ctx context.Context, req resou
 
 	// Access version 7.138.0 or later is required for the `organization` attribute when `provider_type` is set to `GitHub`
 	if data.ProviderType.ValueString() == gitHubProviderType {
-		if ok, err := util.CheckVersion(r.ProviderData.AccessVersion, AccessVersion); err == nil && ok {
+		if isCapabilitySupported(r.ProviderData, capabilityOIDCGitHubOrganization) {
 			if !enablePermissiveConfiguration && data.Organization.IsNull() {
 				resp.Diagnostics.AddAttributeError(
 					path.Root("organization"),
@@ -210,7 +210,7 @@ func (r oidcConfigurationResource) ValidateConfig(ctx context.Context, req resou
 	}
 
 	if data.ProviderType.ValueString() == githubEnterpriseType {
-		if ok, err := util.CheckVersion(r.ProviderData.AccessVersion, GithubEnterpriseAccessVersion); err == nil && ok {
+		if isCapabilitySupported(r.ProviderData, capabilityOIDCGitHubEnterprise) {
 			if !enablePermissiveConfiguration && data.Organization.IsNull() {
 				resp.Diagnostics.AddAttributeError(
 					path.Root("organization"),
@@ -312,7 +312,7 @@ func (r *oidcConfigurationResource) Create(ctx context.Context, req resource.Cre
 
 	// Access version 7.138.0 or later is required for the `organization` attribute when `provider_type` is set to `GitHub`
 	if providerType == gitHubProviderType {
-		if ok, err := util.CheckVersion(r.ProviderData.AccessVersion, AccessVersion); err == nil && ok {
+		if isCapabilitySupported(r.ProviderData, capabilityOIDCGitHubOrganization) {
 			oidcGithubConfig := oidcConfigurationAPIModel{
 				Organization:                  plan.Organization.ValueString(),
 				EnablePermissiveConfiguration: plan.EnablePermissiveConfiguration.ValueBool(),
@@ -329,7 +329,7 @@ func (r *oidcConfigurationResource) Create(ctx context.Context, req resource.Cre
 
 	// Access version 7.144.0 or later is required for the `organization` attribute when `provider_type` is set to `GitHubEnterprise`
 	if providerType == githubEnterpriseType {
-		if ok, err := util.CheckVersion(r.ProviderData.AccessVersion, GithubEnterpriseAccessVersion); err == nil && ok {
+		if isCapabilitySupported(r.ProviderData, capabilityOIDCGitHubEnterprise) {
 			oidcGithubConfig := oidcConfigurationAPIModel{
 				Organization:                  plan.Organization.ValueString(),
 				EnablePermissiveConfiguration: plan.EnablePermissiveConfiguration.ValueBool(),
@@ -344,7 +344,7 @@ func (r *oidcConfigurationResource) Create(ctx context.Context, req resource.Cre
 		}
 	}
 
-	response, err := r.ProviderData.Client.R().
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
 		SetBody(&oidcConfig).
 		Post(r.CollectionEndpoint)
 	if err != nil {
@@ -379,7 +379,7 @@ func (r *oidcConfigurationResource) Read(ctx context.Context, req resource.ReadR
 
 	var oidcConfig oidcConfigurationAPIModel
 
-	response, err := r.ProviderData.Client.R().
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
 		SetPathParam("name", state.Name.ValueString()).
 		SetResult(&oidcConfig).
 		Get(r.DocumentEndpoint)
@@ -417,7 +417,7 @@ func (r *oidcConfigurationResource) Read(ctx context.Context, req resource.ReadR
 
 	// Access version 7.138.0 or later is required for the `organization` attribute when `provider_type` is set to `GitHub`
 	if oidcConfig.ProviderType == gitHubProviderType {
-		if ok, err := util.CheckVersion(r.ProviderData.AccessVersion, AccessVersion); err == nil && ok {
+		if isCapabilitySupported(r.ProviderData, capabilityOIDCGitHubOrganization) {
 			if len(oidcConfig.Organization) > 0 {
 				state.Organization = types.StringValue(oidcConfig.Organization)
 			}
@@ -431,7 +431,7 @@ func (r *oidcConfigurationResource) Read(ctx context.Context, req resource.ReadR
 
 	// Access version 7.144.0 or later is required for the `organization` attribute when `provider_type` is set to `GitHubEnterprise`
 	if oidcConfig.ProviderType == githubEnterpriseType {
-		if ok, err := util.CheckVersion(r.ProviderData.AccessVersion, GithubEnterpriseAccessVersion); err == nil && ok {
+		if isCapabilitySupported(r.ProviderData, capabilityOIDCGitHubEnterprise) {
 			if len(oidcConfig.Organization) > 0 {
 				state.Organization = types.StringValue(oidcConfig.Organization)
 			}
@@ -498,7 +498,7 @@ func (r *oidcConfigurationResource) Update(ctx context.Context, req resource.Upd
 
 	// Access version 7.138.0 or later is required for the `organization` attribute when `provider_type` is set to `GitHub`
 	if providerType == gitHubProviderType {
-		if ok, err := util.CheckVersion(r.ProviderData.AccessVersion, AccessVersion); err == nil && ok {
+		if isCapabilitySupported(r.ProviderData, capabilityOIDCGitHubOrganization) {
 			oidcGithubConfig := oidcConfigurationAPIModel{
 				Organization:                  plan.Organization.ValueString(),
 				EnablePermissiveConfiguration: plan.EnablePermissiveConfiguration.ValueBool(),
@@ -515,7 +515,7 @@ func (r *oidcConfigurationResource) Update(ctx context.Context, req resource.Upd
 
 	// Access version 7.144.0 or later is required for the `organization` attribute when `provider_type` is set to `GitHubEnterprise`
 	if providerType == githubEnterpriseType {
-		if ok, err := util.CheckVersion(r.ProviderData.AccessVersion, GithubEnterpriseAccessVersion); err == nil && ok {
+		if isCapabilitySupported(r.ProviderData, capabilityOIDCGitHubEnterprise) {
 			oidcGithubConfig := oidcConfigurationAPIModel{
 				Organization:                  plan.Organization.ValueString(),
 				EnablePermissiveConfiguration: plan.EnablePermissiveConfiguration.ValueBool(),
@@ -530,7 +530,7 @@ func (r *oidcConfigurationResource) Update(ctx context.Context, req resource.Upd
 		}
 	}
 
-	response, err := r.ProviderData.Client.R().
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
 		SetPathParam("name", plan.Name.ValueString()).
 		SetBody(&oidcConfig).
 		Put(r.DocumentEndpoint)
@@ -562,7 +562,7 @@ func (r *oidcConfigurationResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
-	response, err := r.ProviderData.Client.R().
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
 		SetPathParam("name", state.Name.ValueString()).
 		Delete(r.DocumentEndpoint)
 	if err != nil {
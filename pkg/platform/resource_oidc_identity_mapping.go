@@ -353,7 +353,7 @@ func (r *odicIdentityMappingResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
-	createReq := r.ProviderData.Client.R().
+	createReq := r.ProviderData.Client.R().SetContext(ctx).
 		SetPathParam("provider_name", plan.ProviderName.ValueString()).
 		SetBody(&odicIdentityMapping)
 
@@ -387,7 +387,7 @@ func (r *odicIdentityMappingResource) Read(ctx context.Context, req resource.Rea
 
 	var odicIdentityMapping odicIdentityMappingAPIModel
 
-	readReq := r.ProviderData.Client.R().
+	readReq := r.ProviderData.Client.R().SetContext(ctx).
 		SetPathParams(map[string]string{
 			"provider_name": state.ProviderName.ValueString(),
 			"name":          state.Name.ValueString(),
@@ -443,7 +443,7 @@ func (r *odicIdentityMappingResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
-	updateReq := r.ProviderData.Client.R().
+	updateReq := r.ProviderData.Client.R().SetContext(ctx).
 		SetPathParams(map[string]string{
 			"provider_name": plan.ProviderName.ValueString(),
 			"name":          plan.Name.ValueString(),
@@ -479,7 +479,7 @@ func (r *odicIdentityMappingResource) Delete(ctx context.Context, req resource.D
 		return
 	}
 
-	deleteReq := r.ProviderData.Client.R().
+	deleteReq := r.ProviderData.Client.R().SetContext(ctx).
 		SetPathParams(map[string]string{
 			"provider_name": state.ProviderName.ValueString(),
 			"name":          state.Name.ValueString(),
@@ -435,6 +435,11 @@ func (r permissionResource) ConfigValidators(ctx context.Context) []resource.Con
 }
 
 func (r *permissionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(checkCapability(r.ProviderData, capabilityPermission)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var config permissionResourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
@@ -876,23 +881,16 @@ func (r *permissionResource) Configure(ctx context.Context, req resource.Configu
 		return
 	}
 	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
-
-	ok, err := util.CheckVersion(r.ProviderData.ArtifactoryVersion, "7.72.0")
-	if err != nil {
-		resp.Diagnostics.AddError("failed to check Artifactory version", err.Error())
-	}
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unsupported Artifactory version",
-			"Access Permission API is only support by Artifactory version 7.72.0 or later",
-		)
-	}
 }
 
 func (r *permissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
 
+	resp.Diagnostics.Append(checkCapability(r.ProviderData, capabilityPermission)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var plan permissionResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -907,7 +905,7 @@ func (r *permissionResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 
 	var jfrogErrors util.JFrogErrors
-	response, err := r.ProviderData.Client.R().
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
 		SetBody(&permission).
 		SetError(&jfrogErrors).
 		Post(PermissionEndpoint)
@@ -937,7 +935,7 @@ func (r *permissionResource) Read(ctx context.Context, req resource.ReadRequest,
 	var permission PermissionAPIModel
 	var jfrogErrors util.JFrogErrors
 
-	response, err := r.ProviderData.Client.R().
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
 		SetPathParam("name", state.Name.ValueString()).
 		SetResult(&permission).
 		SetError(&jfrogErrors).
@@ -973,6 +971,11 @@ func (r *permissionResource) Read(ctx context.Context, req resource.ReadRequest,
 func (r *permissionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
 
+	resp.Diagnostics.Append(checkCapability(r.ProviderData, capabilityPermission)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var plan permissionResourceModel
 	var state permissionResourceModel
 
@@ -1005,7 +1008,7 @@ func (r *permissionResource) Update(ctx context.Context, req resource.UpdateRequ
 	// permission can only be updated by resource type, not in its entirety!
 	// so loop through every field and update each value
 	for resourceType, resourceValue := range planPermission.Resources {
-		request := r.ProviderData.Client.R().
+		request := r.ProviderData.Client.R().SetContext(ctx).
 			SetPathParams(map[string]string{
 				"name":         plan.Name.ValueString(),
 				"resourceType": resourceType,
@@ -1033,7 +1036,7 @@ func (r *permissionResource) Update(ctx context.Context, req resource.UpdateRequ
 		// resourceType doesn't exist in plan any more
 		if _, ok := planPermission.Resources[resourceType]; !ok {
 			// delete the permission resource
-			response, err = r.ProviderData.Client.R().
+			response, err = r.ProviderData.Client.R().SetContext(ctx).
 				SetPathParams(map[string]string{
 					"name":         plan.Name.ValueString(),
 					"resourceType": resourceType,
@@ -1069,7 +1072,7 @@ func (r *permissionResource) Delete(ctx context.Context, req resource.DeleteRequ
 
 	var jfrogErrors util.JFrogErrors
 
-	response, err := r.ProviderData.Client.R().
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
 		SetPathParam("name", data.Name.ValueString()).
 		SetError(&jfrogErrors).
 		Delete(PermissionEndpoint + "/{name}")
@@ -17,6 +17,7 @@ package platform
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
 	"strings"
 
@@ -40,12 +41,30 @@ import (
 )
 
 const reversProxyEndpoint = "/artifactory/api/system/configuration/webServer"
+const reverseProxySnippetEndpoint = "/artifactory/api/system/configuration/reverseProxy/{serverProvider}"
 const maxPortNumber = 65535
+const defaultHttpPort = 80
+const defaultHttpsPort = 443
 
 var supportedDockerProxyMethods = []string{"SUBDOMAIN", "REPOPATHPREFIX", "PORTPERREPO"}
 var supportedServerProviderTypes = []string{"DIRECT", "NGINX", "APACHE"}
 
+// reverseProxyDefaults is the direct mode configuration restored on destroy
+// when restore_defaults_on_destroy is set.
+var reverseProxyDefaults = reverseProxyAPIModel{
+	Key:                      "direct",
+	WebServerType:            "DIRECT",
+	ArtifactoryAppContext:    "artifactory",
+	PublicAppContext:         "artifactory",
+	DockerReverseProxyMethod: "SUBDOMAIN",
+	UseHttp:                  true,
+	UseHttps:                 false,
+	HttpPort:                 defaultHttpPort,
+	HttpsPort:                defaultHttpsPort,
+}
+
 var _ resource.Resource = (*reverseProxyResource)(nil)
+var _ resource.ResourceWithIdentity = (*reverseProxyResource)(nil)
 
 type reverseProxyResource struct {
 	ProviderData util.ProviderMetadata
@@ -68,7 +87,7 @@ func (r *reverseProxyResource) Schema(ctx context.Context, req resource.SchemaRe
 			"docker_reverse_proxy_method": schema.StringAttribute{
 				Optional:    true,
 				Computed:    true,
-				Description: fmt.Sprintf("Docker access method. The default value is SUBDOMAIN. Supported values: %s.", strings.Join(supportedDockerProxyMethods, ", ")),
+				Description: fmt.Sprintf("Docker access method. The default value is SUBDOMAIN. Supported values: %s. With SUBDOMAIN, `public_server_name` must be a domain name with a wildcard DNS record.", strings.Join(supportedDockerProxyMethods, ", ")),
 				Default:     stringdefault.StaticString("SUBDOMAIN"),
 				Validators:  []validator.String{stringvalidator.OneOf(supportedDockerProxyMethods...)},
 				PlanModifiers: []planmodifier.String{
@@ -112,26 +131,26 @@ func (r *reverseProxyResource) Schema(ctx context.Context, req resource.SchemaRe
 			"http_port": schema.Int64Attribute{
 				Optional: true,
 				Computed: true,
-				Default:  int64default.StaticInt64(80),
+				Default:  int64default.StaticInt64(defaultHttpPort),
 				Validators: []validator.Int64{
-					int64validator.AtMost(maxPortNumber),
+					int64validator.Between(1, maxPortNumber),
 				},
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.UseStateForUnknown(),
 				},
-				Description: "The port for access via HTTP. The default value is 80. Only settable when `server_provider` is set to `NIGNIX` or `APACHE`",
+				Description: "The port for access via HTTP, between 1 and 65535. The default value is 80. Only settable when `server_provider` is set to `NIGNIX` or `APACHE`",
 			},
 			"https_port": schema.Int64Attribute{
 				Optional: true,
 				Computed: true,
-				Default:  int64default.StaticInt64(443),
+				Default:  int64default.StaticInt64(defaultHttpsPort),
 				Validators: []validator.Int64{
-					int64validator.AtMost(maxPortNumber),
+					int64validator.Between(1, maxPortNumber),
 				},
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.UseStateForUnknown(),
 				},
-				Description: "The port for access via HTTPS. The default value is 443. Only settable when `use_https` is set to `true`",
+				Description: "The port for access via HTTPS, between 1 and 65535. Must be different from `http_port`. The default value is 443. Only settable when `use_https` is set to `true`",
 			},
 			"ssl_key_path": schema.StringAttribute{
 				Optional:    true,
@@ -153,6 +172,11 @@ func (r *reverseProxyResource) Schema(ctx context.Context, req resource.SchemaRe
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"restore_defaults_on_destroy": restoreDefaultsOnDestroyAttribute("`server_provider` set to `DIRECT` with the default ports"),
+			"generated_config": schema.StringAttribute{
+				Computed:    true,
+				Description: "The NGINX configuration snippet rendered by Artifactory for these settings, to be used as the web server configuration. Only set when `server_provider` is set to `NGINX`.",
+			},
 		},
 		MarkdownDescription: "Provides a JFrog [Reverse Proxy](https://jfrog.com/help/r/jfrog-artifactory-documentation/reverse-proxy-settings) resource.\n\n~>Only available for self-hosted instances.",
 	}
@@ -168,6 +192,8 @@ type reverseProxyResourceModel struct {
 	HttpsPort                types.Int64  `tfsdk:"https_port"`
 	SslKeyPath               types.String `tfsdk:"ssl_key_path"`
 	SslCertificatePath       types.String `tfsdk:"ssl_certificate_path"`
+	RestoreDefaultsOnDestroy types.Bool   `tfsdk:"restore_defaults_on_destroy"`
+	GeneratedConfig          types.String `tfsdk:"generated_config"`
 }
 
 func (r *reverseProxyResourceModel) toAPIModel(_ context.Context, apiModel *reverseProxyAPIModel) (ds diag.Diagnostics) {
@@ -209,6 +235,11 @@ func (r *reverseProxyResourceModel) fromAPIModel(_ context.Context, apiModel *re
 		r.SslCertificatePath = types.StringValue(apiModel.SslCertificate)
 	}
 
+	// restore_defaults_on_destroy is not stored on the server, only set its default after import
+	if r.RestoreDefaultsOnDestroy.IsNull() {
+		r.RestoreDefaultsOnDestroy = types.BoolValue(false)
+	}
+
 	return
 }
 
@@ -236,6 +267,50 @@ func (r *reverseProxyResource) Configure(ctx context.Context, req resource.Confi
 	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
 }
 
+// getGeneratedConfig fetches the web server configuration snippet rendered by
+// Artifactory for the current settings. Artifactory only documents the
+// snippet for NGINX, there is none for Apache nor in direct mode.
+func (r *reverseProxyResource) getGeneratedConfig(ctx context.Context, serverProvider string) (types.String, error) {
+	if serverProvider != "NGINX" {
+		return types.StringNull(), nil
+	}
+
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
+		SetPathParam("serverProvider", strings.ToLower(serverProvider)).
+		SetHeader("Accept", "text/plain").
+		Get(reverseProxySnippetEndpoint)
+	if err != nil {
+		return types.StringNull(), err
+	}
+
+	if response.IsError() {
+		return types.StringNull(), fmt.Errorf("%s", response.String())
+	}
+
+	return types.StringValue(response.String()), nil
+}
+
+// refreshGeneratedConfig sets generated_config of the model. The settings are
+// saved at this point, so failing to fetch the snippet is only a warning.
+func (r *reverseProxyResource) refreshGeneratedConfig(ctx context.Context, model *reverseProxyResourceModel) (ds diag.Diagnostics) {
+	generatedConfig, err := r.getGeneratedConfig(ctx, model.ServerProvider.ValueString())
+	if err != nil {
+		ds.AddAttributeWarning(
+			path.Root("generated_config"),
+			"Unable to Read Generated Configuration",
+			fmt.Sprintf("Failed to fetch the %s configuration snippet: %s", model.ServerProvider.ValueString(), err.Error()),
+		)
+
+		if model.GeneratedConfig.IsUnknown() {
+			model.GeneratedConfig = types.StringNull()
+		}
+		return
+	}
+
+	model.GeneratedConfig = generatedConfig
+	return
+}
+
 func (r *reverseProxyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
 
@@ -252,8 +327,10 @@ func (r *reverseProxyResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
-	response, err := r.ProviderData.Client.R().
+	// The POST replaces the whole configuration, so it is safe to retry
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
 		SetBody(&reverseProxy).
+		AddRetryCondition(retryTransientErrors).
 		Post(reversProxyEndpoint)
 	if err != nil {
 		utilfw.UnableToCreateResourceError(resp, err.Error())
@@ -265,7 +342,10 @@ func (r *reverseProxyResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	resp.Diagnostics.Append(r.refreshGeneratedConfig(ctx, &plan)...)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setSingletonIdentity(ctx, resp.Identity)...)
 }
 
 func (r *reverseProxyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -280,7 +360,7 @@ func (r *reverseProxyResource) Read(ctx context.Context, req resource.ReadReques
 
 	var reverseProxy reverseProxyAPIModel
 
-	response, err := r.ProviderData.Client.R().
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
 		SetResult(&reverseProxy).
 		Get(reversProxyEndpoint)
 
@@ -308,7 +388,10 @@ func (r *reverseProxyResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	resp.Diagnostics.Append(r.refreshGeneratedConfig(ctx, &state)...)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setSingletonIdentity(ctx, resp.Identity)...)
 }
 
 func (r *reverseProxyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -327,8 +410,10 @@ func (r *reverseProxyResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
-	response, err := r.ProviderData.Client.R().
+	// The POST replaces the whole configuration, so it is safe to retry
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
 		SetBody(&reverseProxy).
+		AddRetryCondition(retryTransientErrors).
 		Post(reversProxyEndpoint)
 	if err != nil {
 		utilfw.UnableToUpdateResourceError(resp, err.Error())
@@ -340,20 +425,52 @@ func (r *reverseProxyResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	resp.Diagnostics.Append(r.refreshGeneratedConfig(ctx, &plan)...)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setSingletonIdentity(ctx, resp.Identity)...)
 }
 
 func (r *reverseProxyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
 
-	resp.Diagnostics.AddWarning(
-		"Unable to Delete Resource",
-		"Reverse proxy cannot be deleted.",
-	)
+	var state reverseProxyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.RestoreDefaultsOnDestroy.ValueBool() {
+		resp.Diagnostics.AddWarning(
+			"Unable to Delete Resource",
+			"Reverse proxy cannot be deleted. Set restore_defaults_on_destroy to true to restore the direct mode on destroy.",
+		)
+		return
+	}
+
+	// The POST replaces the whole configuration, so it is safe to retry
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
+		SetBody(&reverseProxyDefaults).
+		AddRetryCondition(retryTransientErrors).
+		Post(reversProxyEndpoint)
+	if err != nil {
+		utilfw.UnableToDeleteResourceError(resp, err.Error())
+		return
+	}
+
+	if response.IsError() {
+		utilfw.UnableToDeleteResourceError(resp, response.String())
+		return
+	}
 }
 
 func (r *reverseProxyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("server_provider"), req, resp)
+	importSingletonState(ctx, path.Root("server_provider"), req, resp)
+}
+
+func (r *reverseProxyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = singletonIdentitySchema
 }
 
 func (r reverseProxyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
@@ -364,11 +481,15 @@ func (r reverseProxyResource) ValidateConfig(ctx context.Context, req resource.V
 		return
 	}
 
-	if config.InternalHostname.IsUnknown() || config.PublicServerName.IsUnknown() || config.SslKeyPath.IsUnknown() || config.SslCertificatePath.IsUnknown() {
+	// Every check depends on server_provider, the others are skipped when
+	// one of their attributes is unknown.
+	if config.ServerProvider.IsUnknown() {
 		return
 	}
 
 	switch serverProvider := config.ServerProvider.ValueString(); serverProvider {
+	case "DIRECT":
+		validateReverseProxyDirectMode(&config, &resp.Diagnostics)
 	case "NGINX", "APACHE":
 		if config.InternalHostname.IsNull() {
 			resp.Diagnostics.AddAttributeError(
@@ -385,9 +506,28 @@ func (r reverseProxyResource) ValidateConfig(ctx context.Context, req resource.V
 				fmt.Sprintf("public_server_name must be configured when server_provider is set to '%s'.", serverProvider),
 			)
 		}
+
+		// docker_reverse_proxy_method defaults to SUBDOMAIN
+		dockerReverseProxyMethod := config.DockerReverseProxyMethod.ValueString()
+		if config.DockerReverseProxyMethod.IsNull() {
+			dockerReverseProxyMethod = "SUBDOMAIN"
+		}
+
+		if !config.DockerReverseProxyMethod.IsUnknown() && dockerReverseProxyMethod == "SUBDOMAIN" &&
+			!config.PublicServerName.IsNull() && !config.PublicServerName.IsUnknown() &&
+			!isWildcardCapableServerName(config.PublicServerName.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("public_server_name"),
+				"Invalid Attribute Configuration",
+				fmt.Sprintf("public_server_name '%s' cannot be used when docker_reverse_proxy_method is set to 'SUBDOMAIN'. "+
+					"Docker repositories are then accessed as '<repository>.<public_server_name>', which requires a domain name such as 'artifactory.example.com' "+
+					"with a wildcard DNS record, not an IP address, a single label host name or a wildcard expression. "+
+					"Use a domain name, or set docker_reverse_proxy_method to 'REPOPATHPREFIX' or 'PORTPERREPO'.", config.PublicServerName.ValueString()),
+			)
+		}
 	}
 
-	if config.UseHttps.ValueBool() {
+	if config.UseHttps.ValueBool() && config.ServerProvider.ValueString() != "DIRECT" {
 		if config.SslKeyPath.IsNull() {
 			resp.Diagnostics.AddAttributeError(
 				path.Root("ssl_key_path"),
@@ -403,5 +543,68 @@ func (r reverseProxyResource) ValidateConfig(ctx context.Context, req resource.V
 				"ssl_certificate_path must be configured when use_https is set to 'true'.",
 			)
 		}
+
+		httpPort := int64(defaultHttpPort)
+		if !config.HttpPort.IsNull() {
+			httpPort = config.HttpPort.ValueInt64()
+		}
+
+		httpsPort := int64(defaultHttpsPort)
+		if !config.HttpsPort.IsNull() {
+			httpsPort = config.HttpsPort.ValueInt64()
+		}
+
+		if !config.HttpPort.IsUnknown() && !config.HttpsPort.IsUnknown() && httpPort == httpsPort {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("https_port"),
+				"Invalid Attribute Configuration",
+				fmt.Sprintf("https_port must be different from http_port when use_https is set to 'true', as the web server cannot serve HTTP and HTTPS on the same port %d. "+
+					"Change either http_port or https_port.", httpsPort),
+			)
+		}
+	}
+}
+
+// validateReverseProxyDirectMode reports the web server attributes that have no
+// effect in direct mode, where Artifactory is accessed without a web server.
+// Unknown attributes are not reported, as they may still be null.
+func validateReverseProxyDirectMode(config *reverseProxyResourceModel, diags *diag.Diagnostics) {
+	notSettable := func(attributeName string) {
+		diags.AddAttributeError(
+			path.Root(attributeName),
+			"Invalid Attribute Configuration",
+			fmt.Sprintf("%s can only be set when server_provider is set to 'NGINX' or 'APACHE'. "+
+				"Remove %s or change server_provider.", attributeName, attributeName),
+		)
+	}
+
+	if config.UseHttps.ValueBool() {
+		notSettable("use_https")
+	}
+
+	if !config.HttpPort.IsNull() && !config.HttpPort.IsUnknown() && config.HttpPort.ValueInt64() != defaultHttpPort {
+		notSettable("http_port")
 	}
+
+	if !config.HttpsPort.IsNull() && !config.HttpsPort.IsUnknown() && config.HttpsPort.ValueInt64() != defaultHttpsPort {
+		notSettable("https_port")
+	}
+
+	if !config.SslKeyPath.IsNull() && !config.SslKeyPath.IsUnknown() {
+		notSettable("ssl_key_path")
+	}
+
+	if !config.SslCertificatePath.IsNull() && !config.SslCertificatePath.IsUnknown() {
+		notSettable("ssl_certificate_path")
+	}
+}
+
+// isWildcardCapableServerName returns whether Docker repositories can be
+// served as subdomains of serverName, i.e. whether it is a domain name.
+func isWildcardCapableServerName(serverName string) bool {
+	if net.ParseIP(serverName) != nil || strings.ContainsAny(serverName, "*:/") {
+		return false
+	}
+
+	return strings.Contains(strings.Trim(serverName, "."), ".")
 }
@@ -20,6 +20,8 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jfrog/terraform-provider-platform/v2/pkg/platform"
 	"github.com/jfrog/terraform-provider-shared/testutil"
 	"github.com/jfrog/terraform-provider-shared/util"
 )
@@ -70,6 +72,14 @@ func TestAccReverseProxy_full(t *testing.T) {
 	}
 	updatedConfig := util.ExecuteTemplate(reverseProxyName, updatedTemp, updatedTestData)
 
+	apacheTestData := map[string]string{
+		"name":              reverseProxyName,
+		"dockerProxyMethod": "REPOPATHPREFIX",
+		"serverProvider":    "APACHE",
+		"serverName":        "tempurl.org",
+	}
+	apacheConfig := util.ExecuteTemplate(reverseProxyName, temp, apacheTestData)
+
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProviders(),
 		Steps: []resource.TestStep{
@@ -83,6 +93,7 @@ func TestAccReverseProxy_full(t *testing.T) {
 					resource.TestCheckResourceAttr(fqrn, "use_https", "false"),
 					resource.TestCheckResourceAttr(fqrn, "http_port", "80"),
 					resource.TestCheckResourceAttr(fqrn, "https_port", "443"),
+					resource.TestMatchResourceAttr(fqrn, "generated_config", regexp.MustCompile(`tempurl\.org`)),
 					resource.TestCheckNoResourceAttr(fqrn, "ssl_key_path"),
 					resource.TestCheckNoResourceAttr(fqrn, "ssl_certificate_path"),
 				),
@@ -99,6 +110,7 @@ func TestAccReverseProxy_full(t *testing.T) {
 					resource.TestCheckResourceAttr(fqrn, "https_port", updatedTestData["httpsPort"]),
 					resource.TestCheckResourceAttr(fqrn, "ssl_key_path", updatedTestData["sslKeyPath"]),
 					resource.TestCheckResourceAttr(fqrn, "ssl_certificate_path", updatedTestData["sslCertPath"]),
+					resource.TestMatchResourceAttr(fqrn, "generated_config", regexp.MustCompile(`foo/bar\.crt`)),
 				),
 			},
 			{
@@ -108,6 +120,26 @@ func TestAccReverseProxy_full(t *testing.T) {
 				ImportStateVerify:                    true,
 				ImportStateVerifyIdentifierAttribute: "server_provider",
 			},
+			{
+				ResourceName:                         fqrn,
+				ImportState:                          true,
+				ImportStateId:                        "default",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "server_provider",
+			},
+			{
+				ResourceName:    fqrn,
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+			{
+				// The configuration snippet is only generated for NGINX
+				Config: apacheConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "server_provider", apacheTestData["serverProvider"]),
+					resource.TestCheckNoResourceAttr(fqrn, "generated_config"),
+				),
+			},
 		},
 	})
 }
@@ -254,3 +286,154 @@ func testAccReverseProxy_missing_ssl_certificate_path(t *testing.T, serverProvid
 		},
 	}
 }
+
+func TestAccReverseProxy_invalid_combinations(t *testing.T) {
+	testCases := map[string]struct {
+		attributes  string
+		expectError *regexp.Regexp
+	}{
+		"same_http_https_port": {
+			attributes: `
+				server_provider      = "NGINX"
+				public_server_name   = "tempurl.org"
+				internal_hostname    = "localhost"
+				use_https            = true
+				http_port            = 8080
+				https_port           = 8080
+				ssl_key_path         = "/foo/bar.key"
+				ssl_certificate_path = "/foo/bar.crt"`,
+			expectError: regexp.MustCompile(`https_port must be different from http_port`),
+		},
+		"same_default_http_port": {
+			attributes: `
+				server_provider      = "APACHE"
+				public_server_name   = "tempurl.org"
+				internal_hostname    = "localhost"
+				use_https            = true
+				https_port           = 80
+				ssl_key_path         = "/foo/bar.key"
+				ssl_certificate_path = "/foo/bar.crt"`,
+			expectError: regexp.MustCompile(`https_port must be different from http_port`),
+		},
+		"subdomain_ip_address": {
+			attributes: `
+				docker_reverse_proxy_method = "SUBDOMAIN"
+				server_provider             = "NGINX"
+				public_server_name          = "10.0.0.1"
+				internal_hostname           = "localhost"`,
+			expectError: regexp.MustCompile(`public_server_name '10\.0\.0\.1' cannot be used`),
+		},
+		"default_subdomain_single_label": {
+			attributes: `
+				server_provider    = "NGINX"
+				public_server_name = "artifactory"
+				internal_hostname  = "localhost"`,
+			expectError: regexp.MustCompile(`public_server_name 'artifactory' cannot be used`),
+		},
+		"port_out_of_range": {
+			attributes: `
+				server_provider    = "NGINX"
+				public_server_name = "tempurl.org"
+				internal_hostname  = "localhost"
+				http_port          = 0`,
+			expectError: regexp.MustCompile(`value must be between 1 and 65535`),
+		},
+		"subdomain_ip_address_unknown_port": {
+			attributes: `
+				server_provider    = "NGINX"
+				public_server_name = "10.0.0.1"
+				internal_hostname  = "localhost"
+				http_port          = terraform_data.port.output`,
+			expectError: regexp.MustCompile(`public_server_name '10\.0\.0\.1' cannot be used`),
+		},
+		"direct_with_ssl_key_unknown_port": {
+			attributes: `
+				server_provider = "DIRECT"
+				ssl_key_path    = "/foo/bar.key"
+				https_port      = terraform_data.port.output`,
+			expectError: regexp.MustCompile(`ssl_key_path can only be set when server_provider`),
+		},
+		"direct_with_https": {
+			attributes: `
+				server_provider = "DIRECT"
+				use_https       = true
+				https_port      = 8443`,
+			expectError: regexp.MustCompile(`https_port can only be set when server_provider`),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, _, reverseProxyName := testutil.MkNames("test-reverse-proxy", "platform_reverse_proxy")
+
+			// port is unknown until applied, for the attributes referencing it
+			config := fmt.Sprintf(`
+			resource "terraform_data" "port" {
+				input = 8443
+			}
+
+			resource "platform_reverse_proxy" "%s" {
+				%s
+			}`, reverseProxyName, testCase.attributes)
+
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: testAccProviders(),
+				Steps: []resource.TestStep{
+					{
+						Config:      config,
+						ExpectError: testCase.expectError,
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestAccReverseProxy_restore_defaults_on_destroy(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("test-reverse-proxy", "platform_reverse_proxy")
+
+	temp := `
+	resource "platform_reverse_proxy" "{{ .name }}" {
+		server_provider             = "NGINX"
+		public_server_name          = "tempurl.org"
+		internal_hostname           = "localhost"
+		restore_defaults_on_destroy = true
+	}`
+
+	config := util.ExecuteTemplate(name, temp, map[string]string{
+		"name": name,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(),
+		CheckDestroy: func(s *terraform.State) error {
+			c := TestProvider.(*platform.PlatformProvider).Meta.Client
+
+			var reverseProxy struct {
+				WebServerType string `json:"webServerType"`
+			}
+			_, err := c.R().
+				SetResult(&reverseProxy).
+				Get("/artifactory/api/system/configuration/webServer")
+			if err != nil {
+				return err
+			}
+
+			if reverseProxy.WebServerType != "DIRECT" {
+				return fmt.Errorf("error: reverse proxy server provider is %s instead of DIRECT", reverseProxy.WebServerType)
+			}
+
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "server_provider", "NGINX"),
+					resource.TestCheckResourceAttr(fqrn, "restore_defaults_on_destroy", "true"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,410 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
+	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+	"github.com/samber/lo"
+)
+
+// projectRoleAssignmentEndpoint is the project membership of a user or group,
+// with its roles in the project.
+const projectRoleAssignmentEndpoint = "/access/api/v1/projects/{project_key}/{principal_type}s/{principal}"
+
+var roleAssignmentPrincipalTypes = []string{"user", "group"}
+
+var _ resource.Resource = (*roleAssignmentResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*roleAssignmentResource)(nil)
+
+type roleAssignmentResource struct {
+	ProviderData util.ProviderMetadata
+	TypeName     string
+}
+
+func NewRoleAssignmentResource() resource.Resource {
+	return &roleAssignmentResource{
+		TypeName: "platform_role_assignment",
+	}
+}
+
+func (r *roleAssignmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.TypeName
+}
+
+func (r *roleAssignmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"role": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Name of the global role to assign.",
+			},
+			"principal_type": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(roleAssignmentPrincipalTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: fmt.Sprintf("Type of the principal the role is assigned to. Allowed values: %s.", strings.Join(roleAssignmentPrincipalTypes, ", ")),
+			},
+			"principal": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Name of the user or group the role is assigned to.",
+			},
+			"project_key": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					validatorfw_string.ProjectKey(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Key of the project the role is assigned in. The principal is added as member of the project when needed.",
+			},
+			"authoritative": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "When set to `true`, the role is the only role of the principal in the project: other roles are reported as drift and removed on apply. When `false`, the other roles of the principal are left untouched. Default value is `false`.",
+			},
+			"roles": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "All roles of the principal in the project, including `role`.",
+			},
+		},
+		MarkdownDescription: "Provides a JFrog role assignment resource to assign a [global role](https://jfrog.com/help/r/jfrog-platform-administration-documentation/global-and-project-role-types) to a user or a group in a project, through the project membership of the user or group.",
+	}
+}
+
+type roleAssignmentResourceModel struct {
+	Role          types.String `tfsdk:"role"`
+	PrincipalType types.String `tfsdk:"principal_type"`
+	Principal     types.String `tfsdk:"principal"`
+	ProjectKey    types.String `tfsdk:"project_key"`
+	Authoritative types.Bool   `tfsdk:"authoritative"`
+	Roles         types.Set    `tfsdk:"roles"`
+}
+
+// request returns a request on the project membership of the principal.
+func (r *roleAssignmentResourceModel) request(ctx context.Context, client *resty.Client) (*resty.Request, string) {
+	request := client.R().SetContext(ctx).
+		SetPathParams(map[string]string{
+			"project_key":    r.ProjectKey.ValueString(),
+			"principal_type": r.PrincipalType.ValueString(),
+			"principal":      r.Principal.ValueString(),
+		})
+
+	return request, projectRoleAssignmentEndpoint
+}
+
+// assignedRoles returns the roles to save for the principal, given its
+// current roles.
+func (r *roleAssignmentResourceModel) assignedRoles(currentRoles []string) []string {
+	if r.Authoritative.ValueBool() {
+		return []string{r.Role.ValueString()}
+	}
+
+	return lo.Union(currentRoles, []string{r.Role.ValueString()})
+}
+
+func (r *roleAssignmentResourceModel) fromAPIModel(ctx context.Context, apiModel *roleAssignmentAPIModel) (ds diag.Diagnostics) {
+	roles, d := types.SetValueFrom(ctx, types.StringType, apiModel.Roles)
+	if d.HasError() {
+		ds.Append(d...)
+		return
+	}
+	r.Roles = roles
+
+	// authoritative is not stored on the server, only set its default after import
+	if r.Authoritative.IsNull() {
+		r.Authoritative = types.BoolValue(false)
+	}
+
+	return
+}
+
+type roleAssignmentAPIModel struct {
+	Name  string   `json:"name"`
+	Roles []string `json:"roles"`
+}
+
+// getRoleAssignment returns the current roles of the principal in the project,
+// with found false when the principal has none.
+func (r *roleAssignmentResource) getRoleAssignment(ctx context.Context, model *roleAssignmentResourceModel) (assignment roleAssignmentAPIModel, found bool, err error) {
+	request, endpoint := model.request(ctx, r.ProviderData.Client)
+
+	response, err := request.
+		SetResult(&assignment).
+		Get(endpoint)
+	if err != nil {
+		return
+	}
+
+	if response.StatusCode() == http.StatusNotFound {
+		return roleAssignmentAPIModel{Name: model.Principal.ValueString()}, false, nil
+	}
+
+	if response.IsError() {
+		err = fmt.Errorf("%s", response.String())
+		return
+	}
+
+	return assignment, true, nil
+}
+
+// saveRoleAssignment sets the roles of the principal in the project, removing
+// the principal from the project when there are none left.
+func (r *roleAssignmentResource) saveRoleAssignment(ctx context.Context, model *roleAssignmentResourceModel, roles []string) error {
+	request, endpoint := model.request(ctx, r.ProviderData.Client)
+
+	var response *resty.Response
+	var err error
+	if len(roles) == 0 {
+		response, err = request.Delete(endpoint)
+	} else {
+		response, err = request.
+			SetBody(&roleAssignmentAPIModel{
+				Name:  model.Principal.ValueString(),
+				Roles: roles,
+			}).
+			Put(endpoint)
+	}
+	if err != nil {
+		return err
+	}
+
+	// The principal may have been removed from the project already
+	if response.IsError() && !(len(roles) == 0 && response.StatusCode() == http.StatusNotFound) {
+		return fmt.Errorf("%s", response.String())
+	}
+
+	return nil
+}
+
+func (r *roleAssignmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+// ModifyPlan plans the removal of the other roles of the principal in
+// authoritative mode, so they show up as drift.
+func (r *roleAssignmentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state roleAssignmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Authoritative.IsUnknown() || plan.Role.IsUnknown() {
+		return
+	}
+
+	var stateRoles []string
+	resp.Diagnostics.Append(state.Roles.ElementsAs(ctx, &stateRoles, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roles, ds := types.SetValueFrom(ctx, types.StringType, plan.assignedRoles(stateRoles))
+	resp.Diagnostics.Append(ds...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Roles = roles
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *roleAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var plan roleAssignmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	assignment, _, err := r.getRoleAssignment(ctx, &plan)
+	if err != nil {
+		utilfw.UnableToCreateResourceError(resp, err.Error())
+		return
+	}
+
+	assignment.Roles = plan.assignedRoles(assignment.Roles)
+	if err := r.saveRoleAssignment(ctx, &plan, assignment.Roles); err != nil {
+		utilfw.UnableToCreateResourceError(resp, err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(plan.fromAPIModel(ctx, &assignment)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *roleAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var state roleAssignmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	assignment, found, err := r.getRoleAssignment(ctx, &state)
+	if err != nil {
+		utilfw.UnableToRefreshResourceError(resp, err.Error())
+		return
+	}
+
+	// Treat a role removed outside Terraform as a signal to recreate resource
+	// and return early
+	if !found || !lo.Contains(assignment.Roles, state.Role.ValueString()) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Convert from the API data model to the Terraform data model
+	// and refresh any attribute values.
+	resp.Diagnostics.Append(state.fromAPIModel(ctx, &assignment)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *roleAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var plan roleAssignmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	assignment, _, err := r.getRoleAssignment(ctx, &plan)
+	if err != nil {
+		utilfw.UnableToUpdateResourceError(resp, err.Error())
+		return
+	}
+
+	assignment.Roles = plan.assignedRoles(assignment.Roles)
+	if err := r.saveRoleAssignment(ctx, &plan, assignment.Roles); err != nil {
+		utilfw.UnableToUpdateResourceError(resp, err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(plan.fromAPIModel(ctx, &assignment)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *roleAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var state roleAssignmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	assignment, found, err := r.getRoleAssignment(ctx, &state)
+	if err != nil {
+		utilfw.UnableToDeleteResourceError(resp, err.Error())
+		return
+	}
+
+	if !found {
+		return
+	}
+
+	// Only remove this role, the principal keeps its other roles
+	roles := lo.Without(assignment.Roles, state.Role.ValueString())
+	if err := r.saveRoleAssignment(ctx, &state, roles); err != nil {
+		utilfw.UnableToDeleteResourceError(resp, err.Error())
+		return
+	}
+
+	// If the logic reaches here, it implicitly succeeded and will remove
+	// the resource from state if there are no other errors.
+}
+
+func (r *roleAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ":")
+
+	if len(idParts) != 4 || idParts[0] == "" || idParts[2] == "" || idParts[3] == "" || !lo.Contains(roleAssignmentPrincipalTypes, idParts[1]) {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: role:principal_type:principal:project_key, with principal_type one of %s. Got: %q", strings.Join(roleAssignmentPrincipalTypes, ", "), req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("principal_type"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("principal"), idParts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_key"), idParts[3])...)
+}
@@ -0,0 +1,230 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jfrog/terraform-provider-platform/v2/pkg/platform"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccRoleAssignment_full(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("test-role-assignment", "platform_role_assignment")
+	projectKey := strings.ToLower(fmt.Sprintf("proj%d", testutil.RandomInt()))
+
+	temp := `
+	resource "project" "{{ .name }}" {
+		key          = "{{ .projectKey }}"
+		display_name = "{{ .name }}"
+		description  = "test description"
+		admin_privileges {
+			manage_members   = true
+			manage_resources = true
+			index_resources  = true
+		}
+		max_storage_in_gibibytes   = 1
+		block_deployments_on_limit = true
+		email_notification         = false
+	}
+
+	resource "platform_group" "{{ .name }}" {
+		name = "{{ .name }}"
+	}
+
+	resource "platform_global_role" "{{ .name }}" {
+		name         = "{{ .name }}"
+		type         = "CUSTOM_GLOBAL"
+		environments = ["DEV"]
+		actions      = ["READ_REPOSITORY"]
+	}
+
+	resource "platform_role_assignment" "{{ .name }}" {
+		role           = platform_global_role.{{ .name }}.name
+		principal_type = "group"
+		principal      = platform_group.{{ .name }}.name
+		project_key    = project.{{ .name }}.key
+		authoritative  = {{ .authoritative }}
+	}`
+
+	config := util.ExecuteTemplate(name, temp, map[string]any{
+		"name":          name,
+		"projectKey":    projectKey,
+		"authoritative": false,
+	})
+
+	authoritativeConfig := util.ExecuteTemplate(name, temp, map[string]any{
+		"name":          name,
+		"projectKey":    projectKey,
+		"authoritative": true,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"project": {
+				Source: "jfrog/project",
+			},
+		},
+		ProtoV6ProviderFactories: testAccProviders(),
+		CheckDestroy:             testAccRoleAssignmentDestroy(fqrn),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "role", name),
+					resource.TestCheckResourceAttr(fqrn, "principal_type", "group"),
+					resource.TestCheckResourceAttr(fqrn, "principal", name),
+					resource.TestCheckResourceAttr(fqrn, "project_key", projectKey),
+					resource.TestCheckResourceAttr(fqrn, "authoritative", "false"),
+					resource.TestCheckTypeSetElemAttr(fqrn, "roles.*", name),
+				),
+			},
+			{
+				// Removing the role outside Terraform is detected as drift
+				PreConfig: func() {
+					c := TestProvider.(*platform.PlatformProvider).Meta.Client
+					_, err := c.R().
+						SetPathParams(map[string]string{
+							"project_key":    projectKey,
+							"principal_type": "group",
+							"principal":      name,
+						}).
+						Delete("/access/api/v1/projects/{project_key}/{principal_type}s/{principal}")
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(fqrn, plancheck.ResourceActionCreate),
+					},
+				},
+				Check: resource.TestCheckTypeSetElemAttr(fqrn, "roles.*", name),
+			},
+			{
+				Config: authoritativeConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "authoritative", "true"),
+					resource.TestCheckResourceAttr(fqrn, "roles.#", "1"),
+					resource.TestCheckTypeSetElemAttr(fqrn, "roles.*", name),
+				),
+			},
+			{
+				ResourceName:                         fqrn,
+				ImportState:                          true,
+				ImportStateId:                        fmt.Sprintf("%s:group:%s:%s", name, name, projectKey),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "role",
+				ImportStateVerifyIgnore:              []string{"authoritative"},
+			},
+		},
+	})
+}
+
+func TestAccRoleAssignment_invalid_import_id(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("test-role-assignment", "platform_role_assignment")
+
+	config := util.ExecuteTemplate(name, `
+	resource "platform_role_assignment" "{{ .name }}" {
+		role           = "{{ .name }}"
+		principal_type = "user"
+		principal      = "{{ .name }}"
+		project_key    = "myproj"
+	}`, map[string]string{
+		"name": name,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config:        config,
+				ResourceName:  fqrn,
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s:service:%s:myproj", name, name),
+				ExpectError:   regexp.MustCompile(`Unexpected Import Identifier`),
+			},
+		},
+	})
+}
+
+func TestAccRoleAssignment_missing_project_key(t *testing.T) {
+	_, _, name := testutil.MkNames("test-role-assignment", "platform_role_assignment")
+
+	config := util.ExecuteTemplate(name, `
+	resource "platform_role_assignment" "{{ .name }}" {
+		role           = "{{ .name }}"
+		principal_type = "user"
+		principal      = "{{ .name }}"
+	}`, map[string]string{
+		"name": name,
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`The argument "project_key" is required`),
+			},
+		},
+	})
+}
+
+func testAccRoleAssignmentDestroy(fqrn string) func(*terraform.State) error {
+	return func(s *terraform.State) error {
+		c := TestProvider.(*platform.PlatformProvider).Meta.Client
+
+		rs, ok := s.RootModule().Resources[fqrn]
+		if !ok {
+			return fmt.Errorf("error: resource id [%s] not found", fqrn)
+		}
+
+		var assignment struct {
+			Roles []string `json:"roles"`
+		}
+		response, err := c.R().
+			SetPathParams(map[string]string{
+				"project_key":    rs.Primary.Attributes["project_key"],
+				"principal_type": rs.Primary.Attributes["principal_type"],
+				"principal":      rs.Primary.Attributes["principal"],
+			}).
+			SetResult(&assignment).
+			Get("/access/api/v1/projects/{project_key}/{principal_type}s/{principal}")
+		if err != nil {
+			return err
+		}
+
+		if response.IsSuccess() {
+			for _, role := range assignment.Roles {
+				if role == rs.Primary.Attributes["role"] {
+					return fmt.Errorf("error: role %s is still assigned to %s", role, rs.Primary.Attributes["principal"])
+				}
+			}
+		}
+
+		return nil
+	}
+}
@@ -16,10 +16,20 @@ package platform
 
 import (
 	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"encoding/xml"
+	"fmt"
 	"net/http"
 	"regexp"
+	"strings"
+	"time"
 
+	"github.com/go-resty/resty/v2"
 	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -37,19 +47,47 @@ import (
 	"github.com/samber/lo"
 )
 
-func NewSAMLSettingsResource() resource.Resource {
+const SAMLSettingsEndpoint = "access/api/v1/saml"
+
+// idpMetadataTimeout bounds the time spent fetching the IdP metadata.
+const idpMetadataTimeout = 30 * time.Second
+
+// listSAMLSettings returns every SAML configuration of the instance.
+func listSAMLSettings(ctx context.Context, client *resty.Client) ([]SAMLSettingsAPIModel, error) {
+	var samlSettings []SAMLSettingsAPIModel
+
+	response, err := client.R().SetContext(ctx).
+		SetResult(&samlSettings).
+		Get(SAMLSettingsEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.IsError() {
+		return nil, fmt.Errorf("%s", response.String())
+	}
+
+	return samlSettings, nil
+}
+
+func NewSAMLSettingsResource(platformData *PlatformProviderData) resource.Resource {
 	return &SAMLSettingsResource{
+		PlatformData: platformData,
 		JFrogResource: util.JFrogResource{
 			TypeName:                "platform_saml_settings",
 			ValidArtifactoryVersion: "7.83.1",
 			DocumentEndpoint:        "access/api/v1/saml/{name}",
-			CollectionEndpoint:      "access/api/v1/saml",
+			CollectionEndpoint:      SAMLSettingsEndpoint,
 		},
 	}
 }
 
+var _ resource.ResourceWithValidateConfig = (*SAMLSettingsResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*SAMLSettingsResource)(nil)
+
 type SAMLSettingsResource struct {
 	util.JFrogResource
+	PlatformData *PlatformProviderData
 }
 
 type SAMLSettingsResourceModelV0 struct {
@@ -93,6 +131,112 @@ type SAMLSettingsResourceModelV2 struct {
 	UseEncryptedAssertion     types.Bool   `tfsdk:"use_encrypted_assertion"`
 	AutoUserCreation          types.Bool   `tfsdk:"auto_user_creation"`
 	LDAPGroupSettings         types.Set    `tfsdk:"ldap_group_settings"`
+	IdPMetadataXML            types.String `tfsdk:"idp_metadata_xml"`
+	IdPMetadataURL            types.String `tfsdk:"idp_metadata_url"`
+	IdPEntityID               types.String `tfsdk:"idp_entity_id"`
+	CertificateSubject        types.String `tfsdk:"certificate_subject"`
+	CertificateFingerprint    types.String `tfsdk:"certificate_fingerprint"`
+	CertificateNotAfter       types.String `tfsdk:"certificate_not_after"`
+	CertificateExpiryWarnDays types.Int64  `tfsdk:"certificate_expiry_warning_days"`
+	CertificateExpirySeverity types.String `tfsdk:"certificate_expiry_severity"`
+}
+
+const (
+	samlCertificateExpiryWarningDays = 30
+
+	samlCertificateExpirySeverityWarning = "warning"
+	samlCertificateExpirySeverityError   = "error"
+)
+
+// parseSAMLCertificate decodes a Base64 DER certificate, as used by the SAML API,
+// also accepting PEM armor and embedded whitespace.
+func parseSAMLCertificate(certificate string) (*x509.Certificate, error) {
+	if block, _ := pem.Decode([]byte(certificate)); block != nil {
+		return x509.ParseCertificate(block.Bytes)
+	}
+
+	der, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(certificate), ""))
+	if err != nil {
+		return nil, err
+	}
+
+	return x509.ParseCertificate(der)
+}
+
+// setCertificateDetails populates the computed certificate_* attributes from certificate.
+// They are null when the certificate can not be parsed.
+func (r *SAMLSettingsResourceModelV2) setCertificateDetails() error {
+	if r.Certificate.IsUnknown() {
+		r.CertificateSubject = types.StringUnknown()
+		r.CertificateFingerprint = types.StringUnknown()
+		r.CertificateNotAfter = types.StringUnknown()
+		return nil
+	}
+
+	r.CertificateSubject = types.StringNull()
+	r.CertificateFingerprint = types.StringNull()
+	r.CertificateNotAfter = types.StringNull()
+
+	if r.Certificate.IsNull() {
+		return nil
+	}
+
+	cert, err := parseSAMLCertificate(r.Certificate.ValueString())
+	if err != nil {
+		return err
+	}
+
+	fingerprint := sha256.Sum256(cert.Raw)
+	hexBytes := lo.Map(fingerprint[:], func(b byte, _ int) string {
+		return fmt.Sprintf("%02X", b)
+	})
+
+	r.CertificateSubject = types.StringValue(cert.Subject.String())
+	r.CertificateFingerprint = types.StringValue(strings.Join(hexBytes, ":"))
+	r.CertificateNotAfter = types.StringValue(cert.NotAfter.UTC().Format(time.RFC3339))
+
+	return nil
+}
+
+// certificateExpiryDiagnostics reports a certificate that expires within
+// certificate_expiry_warning_days of now, or has already expired.
+func (r *SAMLSettingsResourceModelV2) certificateExpiryDiagnostics(now time.Time) (ds diag.Diagnostics) {
+	if r.CertificateNotAfter.IsNull() || r.CertificateNotAfter.IsUnknown() {
+		return
+	}
+
+	notAfter, err := time.Parse(time.RFC3339, r.CertificateNotAfter.ValueString())
+	if err != nil {
+		return
+	}
+
+	warningDays := int64(samlCertificateExpiryWarningDays)
+	if !r.CertificateExpiryWarnDays.IsNull() && !r.CertificateExpiryWarnDays.IsUnknown() {
+		warningDays = r.CertificateExpiryWarnDays.ValueInt64()
+	}
+
+	addDiagnostic := ds.AddAttributeWarning
+	if r.CertificateExpirySeverity.ValueString() == samlCertificateExpirySeverityError {
+		addDiagnostic = ds.AddAttributeError
+	}
+
+	remaining := notAfter.Sub(now)
+	switch {
+	case remaining <= 0:
+		addDiagnostic(
+			path.Root("certificate"),
+			"SAML Certificate Expired",
+			fmt.Sprintf("The SAML certificate (%s) expired on %s. SAML SSO logins will fail until the identity provider certificate is renewed and certificate is updated.", r.CertificateSubject.ValueString(), r.CertificateNotAfter.ValueString()),
+		)
+	case remaining <= time.Duration(warningDays)*24*time.Hour:
+		addDiagnostic(
+			path.Root("certificate"),
+			"SAML Certificate Expiring Soon",
+			fmt.Sprintf("The SAML certificate (%s) expires on %s, in %d day(s). Renew the identity provider certificate and update certificate before then to avoid an SSO outage.", r.CertificateSubject.ValueString(), r.CertificateNotAfter.ValueString(), int64(remaining.Hours()/24)),
+		)
+	}
+
+	return
 }
 
 func (r *SAMLSettingsResourceModelV2) toAPIModel(ctx context.Context, apiModel *SAMLSettingsAPIModel) diag.Diagnostics {
@@ -269,7 +413,7 @@ var samlSettingsSchemaV0 = map[string]schema.Attribute{
 		Validators: []validator.String{
 			stringvalidator.LengthAtLeast(1),
 		},
-		MarkdownDescription: "The SAML service provider name. This should be a URI that is also known as the entityID, providerID, or entity identity.",
+		MarkdownDescription: "The SAML service provider name. This should be a URI that is also known as the entityID, providerID, or entity identity. Must be unique across all SAML settings of the instance.",
 	},
 	"allow_user_to_access_profile": schema.BoolAttribute{
 		Optional:            true,
@@ -281,7 +425,7 @@ var samlSettingsSchemaV0 = map[string]schema.Attribute{
 		Optional:            true,
 		Computed:            true,
 		Default:             booldefault.StaticBool(false),
-		MarkdownDescription: "When set, clicking on the login link will direct users to the configured SAML login URL. Default value is `false`.",
+		MarkdownDescription: "When set, clicking on the login link will direct users to the configured SAML login URL. Only one SAML settings of the instance can enable it. Default value is `false`.",
 	},
 	"sync_groups": schema.BoolAttribute{
 		Optional:            true,
@@ -347,6 +491,86 @@ var samlSettingsSchemaV2 = lo.Assign(
 			Default:             booldefault.StaticBool(true),
 			MarkdownDescription: "When set, authenticated users are automatically created in Artifactory. When not set, for every request from an SSO user, the user is temporarily associated with default groups (if such groups are defined), and the permissions for these groups apply. Without automatic user creation, you must manually create the user inside Artifactory to manage user permissions not attached to their default groups. Default value is `true`.",
 		},
+		"certificate": schema.StringAttribute{
+			Optional:  true,
+			Computed:  true,
+			Sensitive: true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			MarkdownDescription: "The certificate for SAML Authentication in Base64 format. NOTE! The certificate must contain the public key to allow Artifactory to verify sign-in requests. Required unless `idp_metadata_xml` or `idp_metadata_url` is set, in which case the IdP signing certificate from the metadata is used.",
+		},
+		"login_url": schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+				validatorfw_string.IsURLHttpOrHttps(),
+			},
+			MarkdownDescription: "The identity provider login URL (when you try to login, the service provider redirects to this URL). Required unless `idp_metadata_xml` or `idp_metadata_url` is set, in which case the `SingleSignOnService` location from the metadata is used.",
+		},
+		"logout_url": schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+				stringvalidator.Any(
+					validatorfw_string.IsURLHttpOrHttps(),
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^\{baseUrl\}`),
+						"value must be a valid URL with http/https scheme or start with the {baseUrl} placeholder",
+					),
+				),
+			},
+			MarkdownDescription: "The identity provider logout URL (when you try to logout, the service provider redirects to this URL). Supports the `{baseUrl}` placeholder for dynamic base URL substitution (e.g. `{baseUrl}/ui/login/`). Required unless `idp_metadata_xml` or `idp_metadata_url` is set, in which case the `SingleLogoutService` location from the metadata is used.",
+		},
+		"idp_metadata_xml": schema.StringAttribute{
+			Optional: true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+				stringvalidator.ConflictsWith(path.MatchRoot("idp_metadata_url")),
+			},
+			MarkdownDescription: "SAML 2.0 `EntityDescriptor` metadata XML of the identity provider. When set, `login_url`, `logout_url` and `certificate` are filled from the metadata unless they are set explicitly. Conflicts with `idp_metadata_url`.",
+		},
+		"idp_metadata_url": schema.StringAttribute{
+			Optional: true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+				validatorfw_string.IsURLHttpOrHttps(),
+				stringvalidator.ConflictsWith(path.MatchRoot("idp_metadata_xml")),
+			},
+			MarkdownDescription: "URL of the identity provider SAML 2.0 metadata. The metadata is fetched and parsed by the provider and used the same way as `idp_metadata_xml`. It is fetched when the resource is created and when the URL changes, with a timeout of 30 seconds, not on every plan. Conflicts with `idp_metadata_xml`.",
+		},
+		"idp_entity_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The `entityID` of the identity provider, as found in `idp_metadata_xml` or `idp_metadata_url`.",
+		},
+		"certificate_subject": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Subject distinguished name of `certificate`.",
+		},
+		"certificate_fingerprint": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "SHA-256 fingerprint of `certificate`, as colon separated uppercase hex.",
+		},
+		"certificate_not_after": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Expiry date of `certificate` in RFC 3339 format.",
+		},
+		"certificate_expiry_warning_days": schema.Int64Attribute{
+			Optional: true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+			},
+			MarkdownDescription: fmt.Sprintf("Number of days before `certificate_not_after` from which plan reports the certificate as expiring. An already expired certificate is always reported. Default value is `%d`.", samlCertificateExpiryWarningDays),
+		},
+		"certificate_expiry_severity": schema.StringAttribute{
+			Optional: true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(samlCertificateExpirySeverityWarning, samlCertificateExpirySeverityError),
+			},
+			MarkdownDescription: "Severity of the plan diagnostic for an expiring or expired certificate. Set to `error` to fail the plan. Allowed values: `warning`, `error`. Default value is `warning`.",
+		},
 	},
 )
 
@@ -354,8 +578,396 @@ func (r *SAMLSettingsResource) Schema(ctx context.Context, req resource.SchemaRe
 	resp.Schema = schema.Schema{
 		Version:             2,
 		Attributes:          samlSettingsSchemaV2,
-		MarkdownDescription: "Provides a JFrog [SAML SSO Settings](https://jfrog.com/help/r/jfrog-platform-administration-documentation/saml-sso) resource.\n\n~>This resource supports both JFrog SaaS and Self-Hosted instances. For SaaS instances, the `enable` parameter must currently be activated via a manual API call after the Terraform apply is complete.",
+		MarkdownDescription: "Provides a JFrog [SAML SSO Settings](https://jfrog.com/help/r/jfrog-platform-administration-documentation/saml-sso) resource.\n\n~>This resource supports both JFrog SaaS and Self-Hosted instances. On SaaS instances (`*.jfrog.io` hosts, or the `saas` attribute of the provider set to `true`), `enable` is applied with an additional API call after the settings are created or updated, and the settings are disabled before they are deleted.",
+	}
+}
+
+func (r *SAMLSettingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	r.JFrogResource.ValidateConfig(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data SAMLSettingsResourceModelV2
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.IdPMetadataXML.IsNull() || !data.IdPMetadataURL.IsNull() {
+		return
+	}
+
+	for attrName, value := range map[string]types.String{
+		"login_url":   data.LoginURL,
+		"logout_url":  data.LogoutURL,
+		"certificate": data.Certificate,
+	} {
+		if value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attrName),
+				"Missing Attribute Configuration",
+				fmt.Sprintf("%s must be configured when neither idp_metadata_xml nor idp_metadata_url is set.", attrName),
+			)
+		}
+	}
+}
+
+func (r *SAMLSettingsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to fill in on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, config SAMLSettingsResourceModelV2
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state *SAMLSettingsResourceModelV2
+	if !req.State.Raw.IsNull() {
+		state = &SAMLSettingsResourceModelV2{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Metadata depending on a value only known at apply time is resolved in Create/Update instead
+	if !plan.IdPMetadataXML.IsUnknown() && !plan.IdPMetadataURL.IsUnknown() {
+		if state != nil && !plan.IdPMetadataURL.IsNull() && plan.IdPMetadataURL.Equal(state.IdPMetadataURL) && !state.IdPEntityID.IsNull() {
+			// The metadata is only fetched again when its URL changes
+			plan.keepIdPMetadata(state, &config)
+		} else {
+			resp.Diagnostics.Append(r.applyIdPMetadata(ctx, &plan, &config)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	if err := plan.setCertificateDetails(); err != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("certificate"),
+			"Unable to parse certificate",
+			fmt.Sprintf("certificate is not a valid Base64 encoded X.509 certificate, its expiry can not be checked: %s", err),
+		)
+	}
+
+	resp.Diagnostics.Append(plan.certificateExpiryDiagnostics(time.Now())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.validateOtherProviders(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// validateOtherProviders checks the plan against the other SAML configurations
+// of the instance: the service provider name must be unique, and only one
+// provider can redirect the login page automatically.
+func (r *SAMLSettingsResource) validateOtherProviders(ctx context.Context, plan *SAMLSettingsResourceModelV2) (ds diag.Diagnostics) {
+	if r.ProviderData == nil || plan.Name.IsUnknown() {
+		return
+	}
+
+	checkServiceProviderName := !plan.ServiceProviderName.IsUnknown()
+	checkAutoRedirect := !plan.AutoRedirect.IsUnknown() && plan.AutoRedirect.ValueBool()
+	if !checkServiceProviderName && !checkAutoRedirect {
+		return
 	}
+
+	samlSettings, err := listSAMLSettings(ctx, r.ProviderData.Client)
+	if err != nil {
+		ds.AddWarning(
+			"Unable to list SAML settings",
+			fmt.Sprintf("Other SAML configurations could not be listed, service_provider_name and auto_redirect are not checked against them: %s", err),
+		)
+		return
+	}
+
+	for _, other := range samlSettings {
+		if other.Name == plan.Name.ValueString() {
+			continue
+		}
+
+		if checkServiceProviderName && other.ServiceProviderName == plan.ServiceProviderName.ValueString() {
+			ds.AddAttributeError(
+				path.Root("service_provider_name"),
+				"Duplicate service provider name",
+				fmt.Sprintf("service_provider_name '%s' is already used by SAML settings '%s'. Each SAML identity provider must use a unique service provider name.", other.ServiceProviderName, other.Name),
+			)
+		}
+
+		if checkAutoRedirect && other.AutoRedirect {
+			ds.AddAttributeError(
+				path.Root("auto_redirect"),
+				"Multiple SAML settings with auto redirect",
+				fmt.Sprintf("auto_redirect is already enabled on SAML settings '%s'. Only one SAML identity provider can redirect the login page automatically, set auto_redirect to false on one of them.", other.Name),
+			)
+		}
+	}
+
+	return
+}
+
+// keepIdPMetadata keeps the login_url, logout_url, certificate and
+// idp_entity_id of state filled from the IdP metadata, for every attribute not
+// explicitly set in config.
+func (r *SAMLSettingsResourceModelV2) keepIdPMetadata(state, config *SAMLSettingsResourceModelV2) {
+	r.IdPEntityID = state.IdPEntityID
+
+	if config.LoginURL.IsNull() {
+		r.LoginURL = state.LoginURL
+	}
+
+	if config.LogoutURL.IsNull() {
+		r.LogoutURL = state.LogoutURL
+	}
+
+	if config.Certificate.IsNull() {
+		r.Certificate = state.Certificate
+	}
+}
+
+// idpMetadataClient returns the client fetching the IdP metadata. It sends the
+// requests with the transport of the provider client, for its proxy, TLS,
+// logging and concurrency settings, but without its base URL and credentials.
+func (r *SAMLSettingsResource) idpMetadataClient() *resty.Client {
+	if r.ProviderData == nil {
+		return resty.New().SetTimeout(idpMetadataTimeout)
+	}
+
+	return resty.NewWithClient(&http.Client{
+		Transport: r.ProviderData.Client.GetClient().Transport,
+		Timeout:   idpMetadataTimeout,
+	})
+}
+
+// applyIdPMetadata fills login_url, logout_url, certificate and idp_entity_id
+// from the IdP metadata for every attribute not explicitly set in config.
+func (r *SAMLSettingsResource) applyIdPMetadata(ctx context.Context, plan, config *SAMLSettingsResourceModelV2) (ds diag.Diagnostics) {
+	metadataXML := plan.IdPMetadataXML.ValueString()
+	if !plan.IdPMetadataURL.IsNull() {
+		response, err := r.idpMetadataClient().R().
+			SetContext(ctx).
+			Get(plan.IdPMetadataURL.ValueString())
+		if err != nil {
+			ds.AddAttributeError(
+				path.Root("idp_metadata_url"),
+				"Failed to fetch IdP metadata",
+				err.Error(),
+			)
+			return
+		}
+
+		if response.IsError() {
+			ds.AddAttributeError(
+				path.Root("idp_metadata_url"),
+				"Failed to fetch IdP metadata",
+				fmt.Sprintf("%s returned %s", plan.IdPMetadataURL.ValueString(), response.Status()),
+			)
+			return
+		}
+
+		metadataXML = response.String()
+	}
+
+	if metadataXML == "" {
+		plan.IdPEntityID = types.StringNull()
+		return
+	}
+
+	metadataPath := path.Root("idp_metadata_xml")
+	if !plan.IdPMetadataURL.IsNull() {
+		metadataPath = path.Root("idp_metadata_url")
+	}
+
+	metadata, d := parseSAMLIdPMetadata(metadataXML, metadataPath)
+	ds.Append(d...)
+	if ds.HasError() {
+		return
+	}
+
+	plan.IdPEntityID = types.StringValue(metadata.EntityID)
+
+	if config.LoginURL.IsNull() {
+		plan.LoginURL = types.StringValue(metadata.LoginURL)
+	}
+
+	if config.LogoutURL.IsNull() {
+		if metadata.LogoutURL == "" {
+			ds.AddAttributeError(
+				metadataPath,
+				"Missing SingleLogoutService",
+				"The IdP metadata does not contain a SingleLogoutService endpoint. Set logout_url explicitly.",
+			)
+			return
+		}
+		plan.LogoutURL = types.StringValue(metadata.LogoutURL)
+	}
+
+	if config.Certificate.IsNull() {
+		plan.Certificate = types.StringValue(metadata.Certificate)
+	}
+
+	return
+}
+
+const (
+	samlHTTPRedirectBinding = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect"
+	samlMetadataNamespace   = "urn:oasis:names:tc:SAML:2.0:metadata"
+)
+
+type samlEntitiesDescriptor struct {
+	XMLName           xml.Name
+	EntityDescriptors []samlEntityDescriptor `xml:"EntityDescriptor"`
+}
+
+type samlEntityDescriptor struct {
+	XMLName           xml.Name
+	EntityID          string                 `xml:"entityID,attr"`
+	IDPSSODescriptors []samlIDPSSODescriptor `xml:"IDPSSODescriptor"`
+}
+
+type samlIDPSSODescriptor struct {
+	KeyDescriptors       []samlKeyDescriptor `xml:"KeyDescriptor"`
+	SingleSignOnServices []samlEndpoint      `xml:"SingleSignOnService"`
+	SingleLogoutServices []samlEndpoint      `xml:"SingleLogoutService"`
+}
+
+type samlKeyDescriptor struct {
+	Use              string   `xml:"use,attr"`
+	X509Certificates []string `xml:"KeyInfo>X509Data>X509Certificate"`
+}
+
+type samlEndpoint struct {
+	Binding  string `xml:"Binding,attr"`
+	Location string `xml:"Location,attr"`
+}
+
+type samlIdPMetadata struct {
+	EntityID    string
+	LoginURL    string
+	LogoutURL   string
+	Certificate string
+}
+
+// parseSAMLIdPMetadata extracts the IdP endpoints and signing certificate
+// from SAML 2.0 metadata. An EntitiesDescriptor is accepted as long as it
+// contains exactly one IdP.
+func parseSAMLIdPMetadata(metadataXML string, attrPath path.Path) (*samlIdPMetadata, diag.Diagnostics) {
+	var ds diag.Diagnostics
+
+	var entities samlEntitiesDescriptor
+	if err := xml.Unmarshal([]byte(metadataXML), &entities); err != nil {
+		ds.AddAttributeError(attrPath, "Invalid IdP metadata", fmt.Sprintf("Failed to parse SAML metadata XML: %s", err))
+		return nil, ds
+	}
+
+	var entity samlEntityDescriptor
+	switch entities.XMLName.Local {
+	case "EntityDescriptor":
+		if err := xml.Unmarshal([]byte(metadataXML), &entity); err != nil {
+			ds.AddAttributeError(attrPath, "Invalid IdP metadata", fmt.Sprintf("Failed to parse SAML metadata XML: %s", err))
+			return nil, ds
+		}
+	case "EntitiesDescriptor":
+		idps := lo.Filter(entities.EntityDescriptors, func(e samlEntityDescriptor, _ int) bool {
+			return len(e.IDPSSODescriptors) > 0
+		})
+		if len(idps) != 1 {
+			ds.AddAttributeError(
+				attrPath,
+				"Invalid IdP metadata",
+				fmt.Sprintf("EntitiesDescriptor must contain exactly one identity provider, found %d.", len(idps)),
+			)
+			return nil, ds
+		}
+		entity = idps[0]
+	default:
+		ds.AddAttributeError(
+			attrPath,
+			"Invalid IdP metadata",
+			fmt.Sprintf("Expected a SAML 2.0 EntityDescriptor, found root element '%s'.", entities.XMLName.Local),
+		)
+		return nil, ds
+	}
+
+	if entity.XMLName.Space != "" && entity.XMLName.Space != samlMetadataNamespace {
+		ds.AddAttributeError(
+			attrPath,
+			"Invalid IdP metadata",
+			fmt.Sprintf("EntityDescriptor must be in the '%s' namespace, found '%s'.", samlMetadataNamespace, entity.XMLName.Space),
+		)
+		return nil, ds
+	}
+
+	if len(entity.IDPSSODescriptors) == 0 {
+		ds.AddAttributeError(attrPath, "Invalid IdP metadata", "The metadata does not contain an IDPSSODescriptor.")
+		return nil, ds
+	}
+	idp := entity.IDPSSODescriptors[0]
+
+	metadata := samlIdPMetadata{
+		EntityID: entity.EntityID,
+	}
+
+	if len(idp.SingleSignOnServices) == 0 {
+		ds.AddAttributeError(attrPath, "Invalid IdP metadata", "The metadata does not contain a SingleSignOnService endpoint.")
+		return nil, ds
+	}
+
+	if sso, ok := lo.Find(idp.SingleSignOnServices, func(e samlEndpoint) bool { return e.Binding == samlHTTPRedirectBinding }); ok {
+		metadata.LoginURL = sso.Location
+	} else {
+		metadata.LoginURL = idp.SingleSignOnServices[0].Location
+		ds.AddAttributeWarning(
+			attrPath,
+			"No HTTP-Redirect binding in IdP metadata",
+			fmt.Sprintf("The metadata does not contain a SingleSignOnService with the HTTP-Redirect binding. Using '%s' (%s) as login_url.", metadata.LoginURL, idp.SingleSignOnServices[0].Binding),
+		)
+	}
+
+	if slo, ok := lo.Find(idp.SingleLogoutServices, func(e samlEndpoint) bool { return e.Binding == samlHTTPRedirectBinding }); ok {
+		metadata.LogoutURL = slo.Location
+	} else if len(idp.SingleLogoutServices) > 0 {
+		metadata.LogoutURL = idp.SingleLogoutServices[0].Location
+	}
+
+	// KeyDescriptor without 'use' applies to both signing and encryption
+	var certificates []string
+	for _, key := range idp.KeyDescriptors {
+		if key.Use != "" && key.Use != "signing" {
+			continue
+		}
+		for _, cert := range key.X509Certificates {
+			certificates = append(certificates, strings.Join(strings.Fields(cert), ""))
+		}
+	}
+	certificates = lo.Uniq(certificates)
+
+	if len(certificates) == 0 {
+		ds.AddAttributeError(attrPath, "Invalid IdP metadata", "The metadata does not contain a signing certificate.")
+		return nil, ds
+	}
+
+	if len(certificates) > 1 {
+		ds.AddAttributeWarning(
+			attrPath,
+			"Multiple signing certificates in IdP metadata",
+			fmt.Sprintf("The metadata contains %d signing certificates, typically during an IdP certificate rollover. Only the first one is used; set certificate explicitly to choose another.", len(certificates)),
+		)
+	}
+	metadata.Certificate = certificates[0]
+
+	return &metadata, ds
 }
 
 func (r *SAMLSettingsResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
@@ -449,13 +1061,30 @@ func (r *SAMLSettingsResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	// IdP metadata was not known at plan time
+	if plan.IdPEntityID.IsUnknown() {
+		var config SAMLSettingsResourceModelV2
+		resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(r.applyIdPMetadata(ctx, &plan, &config)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Parse errors were already reported during plan
+	_ = plan.setCertificateDetails()
+
 	var samlSettings SAMLSettingsAPIModel
 	resp.Diagnostics.Append(plan.toAPIModel(ctx, &samlSettings)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	response, err := r.ProviderData.Client.R().
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
 		SetBody(samlSettings).
 		Post(r.CollectionEndpoint)
 
@@ -469,6 +1098,16 @@ func (r *SAMLSettingsResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	if r.PlatformData.isSaaSInstance(r.ProviderData.Client) {
+		if err := r.setSaaSIntegrationEnabled(ctx, plan.Name.ValueString(), plan.Enable.ValueBool()); err != nil {
+			// SaaS creates the settings disabled, keep track of them so they can be fixed or destroyed
+			plan.Enable = types.BoolValue(false)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+			utilfw.UnableToCreateResourceError(resp, err.Error())
+			return
+		}
+	}
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -485,7 +1124,7 @@ func (r *SAMLSettingsResource) Read(ctx context.Context, req resource.ReadReques
 
 	var samlSettings SAMLSettingsAPIModel
 
-	response, err := r.ProviderData.Client.R().
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
 		SetPathParam("name", state.Name.ValueString()).
 		SetResult(&samlSettings).
 		Get(r.DocumentEndpoint)
@@ -514,6 +1153,9 @@ func (r *SAMLSettingsResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	// Parse errors are reported during plan
+	_ = state.setCertificateDetails()
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -528,13 +1170,30 @@ func (r *SAMLSettingsResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	// IdP metadata was not known at plan time
+	if plan.IdPEntityID.IsUnknown() {
+		var config SAMLSettingsResourceModelV2
+		resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(r.applyIdPMetadata(ctx, &plan, &config)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Parse errors were already reported during plan
+	_ = plan.setCertificateDetails()
+
 	var samlSettings SAMLSettingsAPIModel
 	resp.Diagnostics.Append(plan.toAPIModel(ctx, &samlSettings)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	response, err := r.ProviderData.Client.R().
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
 		SetPathParam("name", plan.Name.ValueString()).
 		SetBody(samlSettings).
 		Put(r.DocumentEndpoint)
@@ -549,6 +1208,13 @@ func (r *SAMLSettingsResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	if r.PlatformData.isSaaSInstance(r.ProviderData.Client) {
+		if err := r.setSaaSIntegrationEnabled(ctx, plan.Name.ValueString(), plan.Enable.ValueBool()); err != nil {
+			utilfw.UnableToUpdateResourceError(resp, err.Error())
+			return
+		}
+	}
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -564,7 +1230,15 @@ func (r *SAMLSettingsResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
-	response, err := r.ProviderData.Client.R().
+	// SaaS does not allow removing enabled settings
+	if r.PlatformData.isSaaSInstance(r.ProviderData.Client) && state.Enable.ValueBool() {
+		if err := r.setSaaSIntegrationEnabled(ctx, state.Name.ValueString(), false); err != nil {
+			utilfw.UnableToDeleteResourceError(resp, err.Error())
+			return
+		}
+	}
+
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
 		SetPathParam("name", state.Name.ValueString()).
 		Delete(r.DocumentEndpoint)
 	if err != nil {
@@ -581,6 +1255,27 @@ func (r *SAMLSettingsResource) Delete(ctx context.Context, req resource.DeleteRe
 	// the resource from state if there are no other errors.
 }
 
+// setSaaSIntegrationEnabled toggles enable_integration with the follow-up call
+// required on SaaS instances, which ignore the flag when settings are
+// created or updated.
+func (r *SAMLSettingsResource) setSaaSIntegrationEnabled(ctx context.Context, name string, enabled bool) error {
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
+		SetPathParam("name", name).
+		SetBody(map[string]bool{
+			"enable_integration": enabled,
+		}).
+		Patch(r.DocumentEndpoint)
+	if err != nil {
+		return err
+	}
+
+	if response.IsError() {
+		return fmt.Errorf("failed to set enable_integration to %t on SaaS instance: %s", enabled, response.String())
+	}
+
+	return nil
+}
+
 func (r *SAMLSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
@@ -17,13 +17,18 @@ package platform_test
 import (
 	"fmt"
 	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync/atomic"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/jfrog/terraform-provider-platform/v2/pkg/platform"
 	"github.com/jfrog/terraform-provider-shared/testutil"
 	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/samber/lo"
 )
 
 func TestAccSAMLSettings_full(t *testing.T) {
@@ -352,6 +357,367 @@ func TestAccSAMLSettings_baseUrl_placeholder_in_logout_url(t *testing.T) {
 	})
 }
 
+func TestAccSAMLSettings_toggle_enable(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("test-saml-settings", "platform_saml_settings")
+
+	temp := `
+	resource "platform_saml_settings" "{{ .name }}" {
+		name                         = "{{ .name }}"
+		enable                       = {{ .enable }}
+		certificate                  = "{{ .certificate }}"
+		login_url                    = "http://tempurl.org/login"
+		logout_url                   = "http://tempurl.org/logout"
+		auto_user_creation           = true
+		service_provider_name        = "okta"
+		allow_user_to_access_profile = true
+		auto_redirect                = false
+		sync_groups                  = false
+		verify_audience_restriction  = true
+		use_encrypted_assertion      = false
+	}`
+
+	testData := map[string]string{
+		"name":        name,
+		"enable":      "true",
+		"certificate": "MIICTjCCAbegAwIBAgIBADANBgkqhkiG9w0BAQ0FADBEMQswCQYDVQQGEwJ1czELMAkGA1UECAwCQ0ExFjAUBgNVBAoMDUpGcm9nIFRlc3RpbmcxEDAOBgNVBAMMB1Rlc3RpbmcwHhcNMjQwODA4MTgzNjMxWhcNMjUwODA4MTgzNjMxWjBEMQswCQYDVQQGEwJ1czELMAkGA1UECAwCQ0ExFjAUBgNVBAoMDUpGcm9nIFRlc3RpbmcxEDAOBgNVBAMMB1Rlc3RpbmcwgZ8wDQYJKoZIhvcNAQEBBQADgY0AMIGJAoGBAOPwKU3SxuRaJply2by60NxYmbIPfelhM6sObgPRXbm49Mz4o1nbwH/vwhz1K+klVO4hOiKc5aP5GtQEoBejZbxOXlYlf8YirNqbtEXlIattvZA3tlC8O9oNOzBuT6tRdAA9CvN035p17fN0tpejz7Ptn1G1yUAt9klTUBBZ8eERAgMBAAGjUDBOMB0GA1UdDgQWBBR2y2SefjbqeSHTj+URrKc540YkGTAfBgNVHSMEGDAWgBR2y2SefjbqeSHTj+URrKc540YkGTAMBgNVHRMEBTADAQH/MA0GCSqGSIb3DQEBDQUAA4GBAKxnkFRgLZnQ4U6fWjfuJnx29cKbIq4oBr9RuWEKH2Hhx+jWy/3baNrxE0AsNWTLX6gGVd2qJbfae803AN6ZLx+VrLCWKl+c5MTTZBhuX6G/JvWviavE44P1U4cl2c6w4qvAmY+SY0cnJeWGLCBJ2vJ/fauXS/TIr0IfziSRcVYY",
+	}
+
+	config := util.ExecuteTemplate(name, temp, testData)
+
+	disabledTestData := map[string]string{
+		"name":        name,
+		"enable":      "false",
+		"certificate": testData["certificate"],
+	}
+
+	disabledConfig := util.ExecuteTemplate(name, temp, disabledTestData)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(),
+		CheckDestroy:             testAccSamlSettingsDestroy(fqrn),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "name", name),
+					resource.TestCheckResourceAttr(fqrn, "enable", "true"),
+				),
+			},
+			{
+				Config: disabledConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "enable", "false"),
+				),
+			},
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "enable", "true"),
+				),
+			},
+			{
+				ResourceName:                         fqrn,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateId:                        name,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+		},
+	})
+}
+
+func TestAccSAMLSettings_conflicts_with_other_provider(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("test-saml-settings", "platform_saml_settings")
+	_, _, otherName := testutil.MkNames("test-saml-settings", "platform_saml_settings")
+
+	temp := `
+	resource "platform_saml_settings" "{{ .name }}" {
+		name                         = "{{ .name }}"
+		enable                       = false
+		certificate                  = "{{ .certificate }}"
+		login_url                    = "http://tempurl.org/login"
+		logout_url                   = "http://tempurl.org/logout"
+		service_provider_name        = "{{ .name }}"
+		auto_user_creation           = true
+		allow_user_to_access_profile = true
+		auto_redirect                = true
+		sync_groups                  = false
+		verify_audience_restriction  = true
+		use_encrypted_assertion      = false
+	}`
+
+	otherTemp := `
+	resource "platform_saml_settings" "{{ .other_name }}" {
+		name                         = "{{ .other_name }}"
+		enable                       = false
+		certificate                  = "{{ .certificate }}"
+		login_url                    = "http://tempurl.org/login"
+		logout_url                   = "http://tempurl.org/logout"
+		service_provider_name        = "{{ .service_provider_name }}"
+		auto_user_creation           = true
+		allow_user_to_access_profile = true
+		auto_redirect                = {{ .auto_redirect }}
+		sync_groups                  = false
+		verify_audience_restriction  = true
+		use_encrypted_assertion      = false
+	}`
+
+	testData := map[string]string{
+		"name":        name,
+		"other_name":  otherName,
+		"certificate": "MIICTjCCAbegAwIBAgIBADANBgkqhkiG9w0BAQ0FADBEMQswCQYDVQQGEwJ1czELMAkGA1UECAwCQ0ExFjAUBgNVBAoMDUpGcm9nIFRlc3RpbmcxEDAOBgNVBAMMB1Rlc3RpbmcwHhcNMjQwODA4MTgzNjMxWhcNMjUwODA4MTgzNjMxWjBEMQswCQYDVQQGEwJ1czELMAkGA1UECAwCQ0ExFjAUBgNVBAoMDUpGcm9nIFRlc3RpbmcxEDAOBgNVBAMMB1Rlc3RpbmcwgZ8wDQYJKoZIhvcNAQEBBQADgY0AMIGJAoGBAOPwKU3SxuRaJply2by60NxYmbIPfelhM6sObgPRXbm49Mz4o1nbwH/vwhz1K+klVO4hOiKc5aP5GtQEoBejZbxOXlYlf8YirNqbtEXlIattvZA3tlC8O9oNOzBuT6tRdAA9CvN035p17fN0tpejz7Ptn1G1yUAt9klTUBBZ8eERAgMBAAGjUDBOMB0GA1UdDgQWBBR2y2SefjbqeSHTj+URrKc540YkGTAfBgNVHSMEGDAWgBR2y2SefjbqeSHTj+URrKc540YkGTAMBgNVHRMEBTADAQH/MA0GCSqGSIb3DQEBDQUAA4GBAKxnkFRgLZnQ4U6fWjfuJnx29cKbIq4oBr9RuWEKH2Hhx+jWy/3baNrxE0AsNWTLX6gGVd2qJbfae803AN6ZLx+VrLCWKl+c5MTTZBhuX6G/JvWviavE44P1U4cl2c6w4qvAmY+SY0cnJeWGLCBJ2vJ/fauXS/TIr0IfziSRcVYY",
+	}
+
+	config := util.ExecuteTemplate(name, temp, testData)
+
+	duplicateNameData := lo.Assign(testData, map[string]string{
+		"service_provider_name": name,
+		"auto_redirect":         "false",
+	})
+	duplicateNameConfig := config + util.ExecuteTemplate(otherName, otherTemp, duplicateNameData)
+
+	duplicateRedirectData := lo.Assign(testData, map[string]string{
+		"service_provider_name": otherName,
+		"auto_redirect":         "true",
+	})
+	duplicateRedirectConfig := config + util.ExecuteTemplate(otherName, otherTemp, duplicateRedirectData)
+
+	validData := lo.Assign(testData, map[string]string{
+		"service_provider_name": otherName,
+		"auto_redirect":         "false",
+	})
+	validConfig := config + util.ExecuteTemplate(otherName, otherTemp, validData)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(),
+		CheckDestroy:             testAccSamlSettingsDestroy(fqrn),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr(fqrn, "auto_redirect", "true"),
+			},
+			{
+				Config:      duplicateNameConfig,
+				ExpectError: regexp.MustCompile(`.*Duplicate service provider name.*`),
+			},
+			{
+				Config:      duplicateRedirectConfig,
+				ExpectError: regexp.MustCompile(`.*Multiple SAML settings with auto redirect.*`),
+			},
+			{
+				Config: validConfig,
+				Check:  resource.TestCheckResourceAttr(fmt.Sprintf("platform_saml_settings.%s", otherName), "service_provider_name", otherName),
+			},
+		},
+	})
+}
+
+func TestAccSAMLSettings_idp_metadata_xml(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("test-saml-settings", "platform_saml_settings")
+
+	temp := `
+	resource "platform_saml_settings" "{{ .name }}" {
+		name                  = "{{ .name }}"
+		enable                = true
+		service_provider_name = "okta"
+		idp_metadata_xml      = <<-EOT
+		<?xml version="1.0" encoding="UTF-8"?>
+		<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" entityID="http://www.okta.com/{{ .name }}">
+		  <md:IDPSSODescriptor WantAuthnRequestsSigned="false" protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+		    <md:KeyDescriptor use="signing">
+		      <ds:KeyInfo xmlns:ds="http://www.w3.org/2000/09/xmldsig#">
+		        <ds:X509Data>
+		          <ds:X509Certificate>{{ .certificate }}</ds:X509Certificate>
+		        </ds:X509Data>
+		      </ds:KeyInfo>
+		    </md:KeyDescriptor>
+		    <md:SingleLogoutService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="http://tempurl.org/logout"/>
+		    <md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="http://tempurl.org/login/post"/>
+		    <md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="http://tempurl.org/login"/>
+		  </md:IDPSSODescriptor>
+		</md:EntityDescriptor>
+		EOT
+	}`
+
+	testData := map[string]string{
+		"name":        name,
+		"certificate": "MIICTjCCAbegAwIBAgIBADANBgkqhkiG9w0BAQ0FADBEMQswCQYDVQQGEwJ1czELMAkGA1UECAwCQ0ExFjAUBgNVBAoMDUpGcm9nIFRlc3RpbmcxEDAOBgNVBAMMB1Rlc3RpbmcwHhcNMjQwODA4MTgzNjMxWhcNMjUwODA4MTgzNjMxWjBEMQswCQYDVQQGEwJ1czELMAkGA1UECAwCQ0ExFjAUBgNVBAoMDUpGcm9nIFRlc3RpbmcxEDAOBgNVBAMMB1Rlc3RpbmcwgZ8wDQYJKoZIhvcNAQEBBQADgY0AMIGJAoGBAOPwKU3SxuRaJply2by60NxYmbIPfelhM6sObgPRXbm49Mz4o1nbwH/vwhz1K+klVO4hOiKc5aP5GtQEoBejZbxOXlYlf8YirNqbtEXlIattvZA3tlC8O9oNOzBuT6tRdAA9CvN035p17fN0tpejz7Ptn1G1yUAt9klTUBBZ8eERAgMBAAGjUDBOMB0GA1UdDgQWBBR2y2SefjbqeSHTj+URrKc540YkGTAfBgNVHSMEGDAWgBR2y2SefjbqeSHTj+URrKc540YkGTAMBgNVHRMEBTADAQH/MA0GCSqGSIb3DQEBDQUAA4GBAKxnkFRgLZnQ4U6fWjfuJnx29cKbIq4oBr9RuWEKH2Hhx+jWy/3baNrxE0AsNWTLX6gGVd2qJbfae803AN6ZLx+VrLCWKl+c5MTTZBhuX6G/JvWviavE44P1U4cl2c6w4qvAmY+SY0cnJeWGLCBJ2vJ/fauXS/TIr0IfziSRcVYY",
+	}
+
+	config := util.ExecuteTemplate(name, temp, testData)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(),
+		CheckDestroy:             testAccSamlSettingsDestroy(fqrn),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "name", testData["name"]),
+					resource.TestCheckResourceAttr(fqrn, "idp_entity_id", fmt.Sprintf("http://www.okta.com/%s", name)),
+					resource.TestCheckResourceAttr(fqrn, "login_url", "http://tempurl.org/login"),
+					resource.TestCheckResourceAttr(fqrn, "logout_url", "http://tempurl.org/logout"),
+					resource.TestCheckResourceAttr(fqrn, "certificate", testData["certificate"]),
+				),
+			},
+			{
+				ResourceName:                         fqrn,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateId:                        name,
+				ImportStateVerifyIdentifierAttribute: "name",
+				ImportStateVerifyIgnore:              []string{"idp_metadata_xml", "idp_entity_id"},
+			},
+		},
+	})
+}
+
+func TestAccSAMLSettings_idp_metadata_url(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("test-saml-settings", "platform_saml_settings")
+
+	certificate := "MIICTjCCAbegAwIBAgIBADANBgkqhkiG9w0BAQ0FADBEMQswCQYDVQQGEwJ1czELMAkGA1UECAwCQ0ExFjAUBgNVBAoMDUpGcm9nIFRlc3RpbmcxEDAOBgNVBAMMB1Rlc3RpbmcwHhcNMjQwODA4MTgzNjMxWhcNMjUwODA4MTgzNjMxWjBEMQswCQYDVQQGEwJ1czELMAkGA1UECAwCQ0ExFjAUBgNVBAoMDUpGcm9nIFRlc3RpbmcxEDAOBgNVBAMMB1Rlc3RpbmcwgZ8wDQYJKoZIhvcNAQEBBQADgY0AMIGJAoGBAOPwKU3SxuRaJply2by60NxYmbIPfelhM6sObgPRXbm49Mz4o1nbwH/vwhz1K+klVO4hOiKc5aP5GtQEoBejZbxOXlYlf8YirNqbtEXlIattvZA3tlC8O9oNOzBuT6tRdAA9CvN035p17fN0tpejz7Ptn1G1yUAt9klTUBBZ8eERAgMBAAGjUDBOMB0GA1UdDgQWBBR2y2SefjbqeSHTj+URrKc540YkGTAfBgNVHSMEGDAWgBR2y2SefjbqeSHTj+URrKc540YkGTAMBgNVHRMEBTADAQH/MA0GCSqGSIb3DQEBDQUAA4GBAKxnkFRgLZnQ4U6fWjfuJnx29cKbIq4oBr9RuWEKH2Hhx+jWy/3baNrxE0AsNWTLX6gGVd2qJbfae803AN6ZLx+VrLCWKl+c5MTTZBhuX6G/JvWviavE44P1U4cl2c6w4qvAmY+SY0cnJeWGLCBJ2vJ/fauXS/TIr0IfziSRcVYY"
+	metadata := fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?>
+<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" entityID="http://www.okta.com/%s">
+  <md:IDPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+    <md:KeyDescriptor use="signing">
+      <ds:KeyInfo xmlns:ds="http://www.w3.org/2000/09/xmldsig#">
+        <ds:X509Data>
+          <ds:X509Certificate>%s</ds:X509Certificate>
+        </ds:X509Data>
+      </ds:KeyInfo>
+    </md:KeyDescriptor>
+    <md:SingleLogoutService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="http://tempurl.org/logout"/>
+    <md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="http://tempurl.org/login"/>
+  </md:IDPSSODescriptor>
+</md:EntityDescriptor>`, name, certificate)
+
+	// The IdP becomes unavailable after the resource is created, the
+	// metadata must not be fetched again while its URL is unchanged
+	var unavailable atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if unavailable.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/samlmetadata+xml")
+		fmt.Fprint(w, metadata)
+	}))
+	defer server.Close()
+
+	config := fmt.Sprintf(`
+	resource "platform_saml_settings" "%s" {
+		name                  = "%s"
+		enable                = true
+		service_provider_name = "okta"
+		idp_metadata_url      = "%s/metadata"
+	}`, name, name, server.URL)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(),
+		CheckDestroy:             testAccSamlSettingsDestroy(fqrn),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "idp_entity_id", fmt.Sprintf("http://www.okta.com/%s", name)),
+					resource.TestCheckResourceAttr(fqrn, "login_url", "http://tempurl.org/login"),
+					resource.TestCheckResourceAttr(fqrn, "logout_url", "http://tempurl.org/logout"),
+					resource.TestCheckResourceAttr(fqrn, "certificate", certificate),
+				),
+			},
+			{
+				PreConfig: func() { unavailable.Store(true) },
+				Config:    config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccSAMLSettings_missing_login_url_without_idp_metadata(t *testing.T) {
+	_, _, name := testutil.MkNames("test-saml-settings", "platform_saml_settings")
+
+	temp := `
+	resource "platform_saml_settings" "{{ .name }}" {
+		name                  = "{{ .name }}"
+		certificate           = "MIICTjCCAbegAwIBAgIBADANBgkqhkiG9w0BAQ0FADBE"
+		logout_url            = "http://tempurl.org/logout"
+		service_provider_name = "okta"
+	}`
+
+	config := util.ExecuteTemplate(name, temp, map[string]string{"name": name})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`login_url must be configured when neither idp_metadata_xml nor\s+idp_metadata_url is set`),
+			},
+		},
+	})
+}
+
+func TestAccSAMLSettings_certificate_expiry(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("test-saml-settings", "platform_saml_settings")
+
+	temp := `
+	resource "platform_saml_settings" "{{ .name }}" {
+		name                        = "{{ .name }}"
+		certificate                 = "{{ .certificate }}"
+		login_url                   = "http://tempurl.org/login"
+		logout_url                  = "http://tempurl.org/logout"
+		service_provider_name       = "okta"
+		certificate_expiry_severity = "{{ .severity }}"
+	}`
+
+	// certificate expired on 2025-08-08
+	testData := map[string]string{
+		"name":        name,
+		"certificate": "MIICTjCCAbegAwIBAgIBADANBgkqhkiG9w0BAQ0FADBEMQswCQYDVQQGEwJ1czELMAkGA1UECAwCQ0ExFjAUBgNVBAoMDUpGcm9nIFRlc3RpbmcxEDAOBgNVBAMMB1Rlc3RpbmcwHhcNMjQwODA4MTgzNjMxWhcNMjUwODA4MTgzNjMxWjBEMQswCQYDVQQGEwJ1czELMAkGA1UECAwCQ0ExFjAUBgNVBAoMDUpGcm9nIFRlc3RpbmcxEDAOBgNVBAMMB1Rlc3RpbmcwgZ8wDQYJKoZIhvcNAQEBBQADgY0AMIGJAoGBAOPwKU3SxuRaJply2by60NxYmbIPfelhM6sObgPRXbm49Mz4o1nbwH/vwhz1K+klVO4hOiKc5aP5GtQEoBejZbxOXlYlf8YirNqbtEXlIattvZA3tlC8O9oNOzBuT6tRdAA9CvN035p17fN0tpejz7Ptn1G1yUAt9klTUBBZ8eERAgMBAAGjUDBOMB0GA1UdDgQWBBR2y2SefjbqeSHTj+URrKc540YkGTAfBgNVHSMEGDAWgBR2y2SefjbqeSHTj+URrKc540YkGTAMBgNVHRMEBTADAQH/MA0GCSqGSIb3DQEBDQUAA4GBAKxnkFRgLZnQ4U6fWjfuJnx29cKbIq4oBr9RuWEKH2Hhx+jWy/3baNrxE0AsNWTLX6gGVd2qJbfae803AN6ZLx+VrLCWKl+c5MTTZBhuX6G/JvWviavE44P1U4cl2c6w4qvAmY+SY0cnJeWGLCBJ2vJ/fauXS/TIr0IfziSRcVYY",
+		"severity":    "warning",
+	}
+
+	config := util.ExecuteTemplate(name, temp, testData)
+
+	errorTestData := map[string]string{
+		"name":        name,
+		"certificate": testData["certificate"],
+		"severity":    "error",
+	}
+
+	errorConfig := util.ExecuteTemplate(name, temp, errorTestData)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(),
+		CheckDestroy:             testAccSamlSettingsDestroy(fqrn),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "certificate_subject", "CN=Testing,O=JFrog Testing,ST=CA,C=us"),
+					resource.TestCheckResourceAttr(fqrn, "certificate_fingerprint", "D5:54:7B:4D:40:0D:1B:0F:82:DA:A5:D1:24:B0:96:40:0D:10:6D:B6:3A:76:07:37:5D:31:36:54:66:FD:98:E2"),
+					resource.TestCheckResourceAttr(fqrn, "certificate_not_after", "2025-08-08T18:36:31Z"),
+				),
+			},
+			{
+				Config:      errorConfig,
+				ExpectError: regexp.MustCompile(`SAML Certificate Expired`),
+			},
+		},
+	})
+}
+
 func testAccSamlSettingsDestroy(id string) func(*terraform.State) error {
 	return func(s *terraform.State) error {
 		c := TestProvider.(*platform.PlatformProvider).Meta.Client
@@ -230,7 +230,7 @@ func (r *SCIMGroupResource) Create(ctx context.Context, req resource.CreateReque
 
 	var result SCIMGroupAPIModel
 	var scimErr SCIMErrorAPIModel
-	response, err := r.ProviderData.Client.R().
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
 		SetBody(group).
 		SetResult(&result).
 		SetError(&scimErr).
@@ -270,7 +270,7 @@ func (r *SCIMGroupResource) Read(ctx context.Context, req resource.ReadRequest,
 	var group SCIMGroupAPIModel
 	var scimErr SCIMErrorAPIModel
 
-	response, err := r.ProviderData.Client.R().
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
 		SetPathParam("name", state.ID.ValueString()).
 		SetResult(&group).
 		SetError(&scimErr).
@@ -322,7 +322,7 @@ func (r *SCIMGroupResource) Update(ctx context.Context, req resource.UpdateReque
 
 	var result SCIMGroupAPIModel
 	var scimErr SCIMErrorAPIModel
-	response, err := r.ProviderData.Client.R().
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
 		SetPathParam("name", plan.ID.ValueString()).
 		SetBody(group).
 		SetResult(&result).
@@ -362,7 +362,7 @@ func (r *SCIMGroupResource) Delete(ctx context.Context, req resource.DeleteReque
 	}
 
 	var scimErr SCIMErrorAPIModel
-	response, err := r.ProviderData.Client.R().
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
 		SetPathParam("name", state.ID.ValueString()).
 		SetError(&scimErr).
 		Delete(SCIMGroupEndpoint)
@@ -39,6 +39,9 @@ import (
 const (
 	SCIMUsersEndpoint = "access/api/v1/scim/v2/Users"
 	SCIMUserEndpoint  = "access/api/v1/scim/v2/Users/{id}"
+
+	SCIMUserSchema           = "urn:ietf:params:scim:schemas:core:2.0:User"
+	SCIMEnterpriseUserSchema = "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User"
 )
 
 func NewSCIMUserResource() resource.Resource {
@@ -53,18 +56,52 @@ type SCIMUserResource struct {
 }
 
 type SCIMUserResourceModel struct {
-	Username types.String `tfsdk:"username"`
-	Active   types.Bool   `tfsdk:"active"`
-	Emails   types.Set    `tfsdk:"emails"`
-	Groups   types.Set    `tfsdk:"groups"`
-	Meta     types.Map    `tfsdk:"meta"`
+	Username    types.String `tfsdk:"username"`
+	Active      types.Bool   `tfsdk:"active"`
+	Name        types.Object `tfsdk:"name"`
+	DisplayName types.String `tfsdk:"display_name"`
+	ExternalID  types.String `tfsdk:"external_id"`
+	Emails      types.Set    `tfsdk:"emails"`
+	Enterprise  types.Object `tfsdk:"enterprise"`
+	Groups      types.Set    `tfsdk:"groups"`
+	Meta        types.Map    `tfsdk:"meta"`
 }
 
 func (r *SCIMUserResourceModel) toAPIModel(_ context.Context, apiModel *SCIMUserAPIModel) (ds diag.Diagnostics) {
-	apiModel.Schemas = []string{"urn:ietf:params:scim:schemas:core:2.0:User"}
+	apiModel.Schemas = []string{SCIMUserSchema}
 
 	apiModel.Username = r.Username.ValueString()
 	apiModel.Active = r.Active.ValueBool()
+	apiModel.DisplayName = r.DisplayName.ValueStringPointer()
+	apiModel.ExternalID = r.ExternalID.ValueStringPointer()
+
+	if !r.Name.IsNull() && !r.Name.IsUnknown() {
+		attrs := r.Name.Attributes()
+		apiModel.Name = &SCIMUserNameAPIModel{
+			Formatted:  attrs["formatted"].(types.String).ValueStringPointer(),
+			GivenName:  attrs["given_name"].(types.String).ValueStringPointer(),
+			MiddleName: attrs["middle_name"].(types.String).ValueStringPointer(),
+			FamilyName: attrs["family_name"].(types.String).ValueStringPointer(),
+		}
+	}
+
+	if !r.Enterprise.IsNull() && !r.Enterprise.IsUnknown() {
+		apiModel.Schemas = append(apiModel.Schemas, SCIMEnterpriseUserSchema)
+
+		attrs := r.Enterprise.Attributes()
+		enterprise := SCIMEnterpriseUserAPIModel{
+			EmployeeNumber: attrs["employee_number"].(types.String).ValueStringPointer(),
+			Department:     attrs["department"].(types.String).ValueStringPointer(),
+		}
+
+		if manager := attrs["manager"].(types.String); !manager.IsNull() {
+			enterprise.Manager = &SCIMEnterpriseUserManagerAPIModel{
+				Value: manager.ValueString(),
+			}
+		}
+
+		apiModel.Enterprise = &enterprise
+	}
 
 	emails := lo.Map[attr.Value](
 		r.Emails.Elements(),
@@ -91,9 +128,65 @@ var SCIMUserGroupResourceModelAttributeType map[string]attr.Type = map[string]at
 	"value": types.StringType,
 }
 
+var SCIMUserNameResourceModelAttributeType map[string]attr.Type = map[string]attr.Type{
+	"formatted":   types.StringType,
+	"given_name":  types.StringType,
+	"middle_name": types.StringType,
+	"family_name": types.StringType,
+}
+
+var SCIMEnterpriseUserResourceModelAttributeType map[string]attr.Type = map[string]attr.Type{
+	"employee_number": types.StringType,
+	"department":      types.StringType,
+	"manager":         types.StringType,
+}
+
 func (r *SCIMUserResourceModel) fromAPIModel(_ context.Context, apiModel *SCIMUserAPIModel) (ds diag.Diagnostics) {
 	r.Username = types.StringValue(apiModel.Username)
 	r.Active = types.BoolValue(apiModel.Active)
+	r.DisplayName = types.StringPointerValue(apiModel.DisplayName)
+	r.ExternalID = types.StringPointerValue(apiModel.ExternalID)
+
+	name := types.ObjectNull(SCIMUserNameResourceModelAttributeType)
+	// Servers may return an empty name object for users created without one
+	if apiModel.Name != nil && (apiModel.Name.Formatted != nil || apiModel.Name.GivenName != nil || apiModel.Name.MiddleName != nil || apiModel.Name.FamilyName != nil) {
+		n, d := types.ObjectValue(
+			SCIMUserNameResourceModelAttributeType,
+			map[string]attr.Value{
+				"formatted":   types.StringPointerValue(apiModel.Name.Formatted),
+				"given_name":  types.StringPointerValue(apiModel.Name.GivenName),
+				"middle_name": types.StringPointerValue(apiModel.Name.MiddleName),
+				"family_name": types.StringPointerValue(apiModel.Name.FamilyName),
+			},
+		)
+		if d.HasError() {
+			ds.Append(d...)
+		}
+		name = n
+	}
+	r.Name = name
+
+	enterprise := types.ObjectNull(SCIMEnterpriseUserResourceModelAttributeType)
+	if apiModel.Enterprise != nil && (apiModel.Enterprise.EmployeeNumber != nil || apiModel.Enterprise.Department != nil || apiModel.Enterprise.Manager != nil) {
+		manager := types.StringNull()
+		if apiModel.Enterprise.Manager != nil && apiModel.Enterprise.Manager.Value != "" {
+			manager = types.StringValue(apiModel.Enterprise.Manager.Value)
+		}
+
+		e, d := types.ObjectValue(
+			SCIMEnterpriseUserResourceModelAttributeType,
+			map[string]attr.Value{
+				"employee_number": types.StringPointerValue(apiModel.Enterprise.EmployeeNumber),
+				"department":      types.StringPointerValue(apiModel.Enterprise.Department),
+				"manager":         manager,
+			},
+		)
+		if d.HasError() {
+			ds.Append(d...)
+		}
+		enterprise = e
+	}
+	r.Enterprise = enterprise
 
 	emails := lo.Map(
 		apiModel.Emails,
@@ -166,12 +259,33 @@ func (r *SCIMUserResourceModel) fromAPIModel(_ context.Context, apiModel *SCIMUs
 }
 
 type SCIMUserAPIModel struct {
-	Schemas  []string                `json:"schemas"`
-	Username string                  `json:"userName"`
-	Active   bool                    `json:"active"`
-	Emails   []SCIMUserEmailAPIModel `json:"emails"`
-	Groups   []SCIMUserGroupAPIModel `json:"groups,omitempty"`
-	Meta     map[string]string       `json:"meta,omitempty"`
+	Schemas     []string                    `json:"schemas"`
+	Username    string                      `json:"userName"`
+	Active      bool                        `json:"active"`
+	Name        *SCIMUserNameAPIModel       `json:"name,omitempty"`
+	DisplayName *string                     `json:"displayName,omitempty"`
+	ExternalID  *string                     `json:"externalId,omitempty"`
+	Emails      []SCIMUserEmailAPIModel     `json:"emails"`
+	Groups      []SCIMUserGroupAPIModel     `json:"groups,omitempty"`
+	Enterprise  *SCIMEnterpriseUserAPIModel `json:"urn:ietf:params:scim:schemas:extension:enterprise:2.0:User,omitempty"`
+	Meta        map[string]string           `json:"meta,omitempty"`
+}
+
+type SCIMUserNameAPIModel struct {
+	Formatted  *string `json:"formatted,omitempty"`
+	GivenName  *string `json:"givenName,omitempty"`
+	MiddleName *string `json:"middleName,omitempty"`
+	FamilyName *string `json:"familyName,omitempty"`
+}
+
+type SCIMEnterpriseUserAPIModel struct {
+	EmployeeNumber *string                            `json:"employeeNumber,omitempty"`
+	Department     *string                            `json:"department,omitempty"`
+	Manager        *SCIMEnterpriseUserManagerAPIModel `json:"manager,omitempty"`
+}
+
+type SCIMEnterpriseUserManagerAPIModel struct {
+	Value string `json:"value"`
 }
 
 type SCIMUserEmailAPIModel struct {
@@ -210,6 +324,54 @@ func (r *SCIMUserResource) Schema(ctx context.Context, req resource.SchemaReques
 				Computed: true,
 				Default:  booldefault.StaticBool(true),
 			},
+			"name": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"formatted": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+						MarkdownDescription: "The full name, including all middle names, titles, and suffixes as appropriate, formatted for display.",
+					},
+					"given_name": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+						MarkdownDescription: "The given name of the user, or first name in most Western languages.",
+					},
+					"middle_name": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+						MarkdownDescription: "The middle name(s) of the user.",
+					},
+					"family_name": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+						MarkdownDescription: "The family name of the user, or last name in most Western languages.",
+					},
+				},
+				Optional:            true,
+				MarkdownDescription: "The components of the user's name.",
+			},
+			"display_name": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				MarkdownDescription: "The name of the user, suitable for display to end-users.",
+			},
+			"external_id": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				MarkdownDescription: "Identifier of the user as defined by the provisioning client (e.g. the IdP object ID).",
+			},
 			"emails": schema.SetNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
@@ -245,6 +407,33 @@ func (r *SCIMUserResource) Schema(ctx context.Context, req resource.SchemaReques
 				},
 				Computed: true,
 			},
+			"enterprise": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"employee_number": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+						MarkdownDescription: "Numeric or alphanumeric identifier assigned to the user, typically based on order of hire or association with an organization.",
+					},
+					"department": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+						MarkdownDescription: "Name of the department the user belongs to.",
+					},
+					"manager": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+						MarkdownDescription: "The `id` of the SCIM user who is the manager of this user.",
+					},
+				},
+				Optional:            true,
+				MarkdownDescription: "Attributes from the SCIM Enterprise User extension (`" + SCIMEnterpriseUserSchema + "`). When set, the extension URN is added to the user's `schemas`.",
+			},
 			"meta": schema.MapAttribute{
 				ElementType: types.StringType,
 				Computed:    true,
@@ -281,7 +470,7 @@ func (r *SCIMUserResource) Create(ctx context.Context, req resource.CreateReques
 
 	var result SCIMUserAPIModel
 	var scimErr SCIMErrorAPIModel
-	response, err := r.ProviderData.Client.R().
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
 		SetBody(user).
 		SetResult(&result).
 		SetError(&scimErr).
@@ -321,7 +510,7 @@ func (r *SCIMUserResource) Read(ctx context.Context, req resource.ReadRequest, r
 	var user SCIMUserAPIModel
 	var scimErr SCIMErrorAPIModel
 
-	response, err := r.ProviderData.Client.R().
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
 		SetPathParam("id", state.Username.ValueString()).
 		SetResult(&user).
 		SetError(&scimErr).
@@ -373,7 +562,7 @@ func (r *SCIMUserResource) Update(ctx context.Context, req resource.UpdateReques
 
 	var result SCIMUserAPIModel
 	var scimErr SCIMErrorAPIModel
-	response, err := r.ProviderData.Client.R().
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
 		SetPathParam("id", plan.Username.ValueString()).
 		SetBody(user).
 		SetResult(&result).
@@ -413,7 +602,7 @@ func (r *SCIMUserResource) Delete(ctx context.Context, req resource.DeleteReques
 	}
 
 	var scimErr SCIMErrorAPIModel
-	response, err := r.ProviderData.Client.R().
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
 		SetPathParam("id", state.Username.ValueString()).
 		SetError(&scimErr).
 		Delete(SCIMUserEndpoint)
@@ -99,6 +99,91 @@ func TestAccSCIMUser_full(t *testing.T) {
 	})
 }
 
+func TestAccSCIMUser_name_and_enterprise(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("test-scim-user", "platform_scim_user")
+
+	temp := `
+	resource "platform_scim_user" "{{ .name }}" {
+		username     = "{{ .email }}"
+		display_name = "{{ .displayName }}"
+		external_id  = "{{ .externalId }}"
+		name = {
+			given_name  = "{{ .givenName }}"
+			family_name = "Doe"
+		}
+		emails = [{
+			value = "{{ .email }}"
+			primary = true
+		}]
+		enterprise = {
+			employee_number = "{{ .employeeNumber }}"
+			department      = "{{ .department }}"
+		}
+	}`
+
+	testData := map[string]string{
+		"name":           name,
+		"email":          fmt.Sprintf("%s@tempurl.org", name),
+		"displayName":    "Jane Doe",
+		"externalId":     "00u1a2b3c4",
+		"givenName":      "Jane",
+		"employeeNumber": "1234",
+		"department":     "Engineering",
+	}
+
+	config := util.ExecuteTemplate(name, temp, testData)
+
+	updatedTestData := map[string]string{
+		"name":           name,
+		"email":          testData["email"],
+		"displayName":    "Janet Doe",
+		"externalId":     "00u1a2b3c4",
+		"givenName":      "Janet",
+		"employeeNumber": "5678",
+		"department":     "Platform",
+	}
+
+	updatedConfig := util.ExecuteTemplate(name, temp, updatedTestData)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(),
+		CheckDestroy:             testAccSCIMUserDestroy(fqrn),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "username", testData["email"]),
+					resource.TestCheckResourceAttr(fqrn, "display_name", testData["displayName"]),
+					resource.TestCheckResourceAttr(fqrn, "external_id", testData["externalId"]),
+					resource.TestCheckResourceAttr(fqrn, "name.given_name", testData["givenName"]),
+					resource.TestCheckResourceAttr(fqrn, "name.family_name", "Doe"),
+					resource.TestCheckNoResourceAttr(fqrn, "name.middle_name"),
+					resource.TestCheckResourceAttr(fqrn, "enterprise.employee_number", testData["employeeNumber"]),
+					resource.TestCheckResourceAttr(fqrn, "enterprise.department", testData["department"]),
+					resource.TestCheckNoResourceAttr(fqrn, "enterprise.manager"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "display_name", updatedTestData["displayName"]),
+					resource.TestCheckResourceAttr(fqrn, "name.given_name", updatedTestData["givenName"]),
+					resource.TestCheckResourceAttr(fqrn, "enterprise.employee_number", updatedTestData["employeeNumber"]),
+					resource.TestCheckResourceAttr(fqrn, "enterprise.department", updatedTestData["department"]),
+				),
+			},
+			{
+				ResourceName:                         fqrn,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateId:                        updatedTestData["email"],
+				ImportStateVerifyIdentifierAttribute: "username",
+			},
+		},
+	})
+}
+
 func testAccSCIMUserDestroy(id string) func(*terraform.State) error {
 	return func(s *terraform.State) error {
 		c := TestProvider.(*platform.PlatformProvider).Meta.Client
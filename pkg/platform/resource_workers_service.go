@@ -460,7 +460,7 @@ func (r *workersServiceResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	response, err := r.ProviderData.Client.R().
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
 		SetBody(&workersService).
 		Post(WorkersServiceEndpoint)
 	if err != nil {
@@ -488,7 +488,7 @@ func (r *workersServiceResource) Read(ctx context.Context, req resource.ReadRequ
 
 	var workersService WorkersServiceAPIModel
 
-	response, err := r.ProviderData.Client.R().
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
 		SetPathParam("key", state.Key.ValueString()).
 		SetResult(&workersService).
 		Get(WorkersServiceEndpoint + "/{key}")
@@ -566,7 +566,7 @@ func (r *workersServiceResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
-	response, err := r.ProviderData.Client.R().
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
 		SetBody(&workersService).
 		Put(WorkersServiceEndpoint)
 	if err != nil {
@@ -595,7 +595,7 @@ func (r *workersServiceResource) Delete(ctx context.Context, req resource.Delete
 
 	key := data.Key.ValueString()
 
-	response, err := r.ProviderData.Client.R().
+	response, err := r.ProviderData.Client.R().SetContext(ctx).
 		SetPathParam("key", key).
 		Delete(WorkersServiceEndpoint + "/{key}")
 	if err != nil {
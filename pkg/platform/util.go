@@ -0,0 +1,131 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package platform
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// jfrogSaaSDomains are the domains JFrog SaaS (Cloud) instances are served from.
+var jfrogSaaSDomains = []string{
+	".jfrog.io",
+}
+
+// isSaaSInstance reports whether the client targets a JFrog SaaS instance:
+// the saas attribute of the provider when set, otherwise whether the host of
+// its base URL is a JFrog SaaS host.
+func (d *PlatformProviderData) isSaaSInstance(client *resty.Client) bool {
+	if d != nil && !d.SaaS.IsNull() && !d.SaaS.IsUnknown() {
+		return d.SaaS.ValueBool()
+	}
+
+	if client == nil {
+		return false
+	}
+
+	u, err := url.Parse(client.BaseURL)
+	if err != nil {
+		return false
+	}
+
+	host := strings.ToLower(u.Hostname())
+	for _, domain := range jfrogSaaSDomains {
+		if strings.HasSuffix(host, domain) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// restoreDefaultsOnDestroyAttribute is the opt-in attribute of the singleton
+// settings resources, which can not be deleted but can be reset to their
+// default settings on destroy.
+func restoreDefaultsOnDestroyAttribute(defaults string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: fmt.Sprintf("When set, destroying the resource restores the default settings (%s). Otherwise the settings are left in place and only removed from the Terraform state. Default value is `false`.", defaults),
+	}
+}
+
+// singletonImportID is the fixed import ID and identity of the singleton
+// settings resources, which exist exactly once per instance.
+const singletonImportID = "default"
+
+var singletonIdentitySchema = identityschema.Schema{
+	Attributes: map[string]identityschema.Attribute{
+		"id": identityschema.StringAttribute{
+			RequiredForImport: true,
+			Description:       "Always `default`, as these settings exist once per instance.",
+		},
+	},
+}
+
+// setSingletonIdentity sets the fixed identity of a singleton settings resource.
+func setSingletonIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+
+	return identity.SetAttribute(ctx, path.Root("id"), singletonImportID)
+}
+
+// importSingletonState imports a singleton settings resource, by import ID or
+// by identity. Besides `default`, the former IDs based on a configuration
+// attribute are still accepted for compatibility. The ID is only a placeholder
+// in legacyAttrPath, the settings are read from the server either way.
+func importSingletonState(ctx context.Context, legacyAttrPath path.Path, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := req.ID
+	if id == "" && req.Identity != nil {
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("id"), &id)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if id != singletonImportID {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id"),
+				"Invalid Import Identity",
+				fmt.Sprintf("Identity id must be '%s', got '%s'.", singletonImportID, id),
+			)
+			return
+		}
+	}
+
+	if id == "" {
+		resp.Diagnostics.AddError(
+			"Missing Import ID",
+			fmt.Sprintf("Use '%s' as import ID.", singletonImportID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, legacyAttrPath, id)...)
+	resp.Diagnostics.Append(setSingletonIdentity(ctx, resp.Identity)...)
+}